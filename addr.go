@@ -0,0 +1,205 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/netip"
+	"reflect"
+)
+
+// Addr represents a nullable IP address wrapping netip.Addr, for PostgreSQL inet columns.
+type Addr struct {
+	Addr  netip.Addr
+	Valid bool
+}
+
+// NewAddr returns a new Addr.
+func NewAddr(a netip.Addr, valid bool) Addr {
+	return Addr{
+		Addr:  a,
+		Valid: valid,
+	}
+}
+
+// NullableString returns the value as a String in the form netip.Addr.String returns,
+// such as "192.0.2.1" or "2001:db8::1".
+func (n Addr) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(n.Addr.String(), true)
+}
+
+// Is4 reports whether the value is an IPv4 address, or returns null if invalid.
+func (n Addr) Is4() Bool {
+	if !n.Valid {
+		return NewBool(false, false)
+	}
+
+	return NewBool(n.Addr.Is4(), true)
+}
+
+// Unmap returns the value with any IPv4-mapped IPv6 prefix removed, or null if invalid.
+func (n Addr) Unmap() Addr {
+	if !n.Valid {
+		return Addr{}
+	}
+
+	return NewAddr(n.Addr.Unmap(), true)
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a string in the form netip.Addr.String returns, or nil if invalid.
+func (n Addr) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	if !n.Addr.IsValid() {
+		return nil, errInvalidAddr
+	}
+
+	return n.Addr.String(), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts one of the following:
+//   - string (IP address, as PostgreSQL inet columns return)
+//   - []byte (IP address, or 4 or 16 bytes in network byte order if it is not one)
+//   - nil
+func (n *Addr) Scan(src any) error {
+	if src == nil {
+		*n = Addr{}
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case string:
+		return n.parse(v)
+
+	case []byte:
+		if a, err := netip.ParseAddr(string(v)); err == nil {
+			*n = NewAddr(a, true)
+
+			return nil
+		}
+
+		if a, ok := netip.AddrFromSlice(v); ok {
+			*n = NewAddr(a, true)
+
+			return nil
+		}
+
+		return n.parse(string(v))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in the form netip.Addr.String returns, or null if invalid.
+func (n Addr) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	if !n.Addr.IsValid() {
+		return nil, errInvalidAddr
+	}
+
+	return json.Marshal(n.Addr.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string in any form netip.ParseAddr accepts, or null.
+func (n *Addr) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = Addr{}
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	return n.parse(s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns the value in the form netip.Addr.String returns, or an empty text if invalid.
+func (n Addr) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	if !n.Addr.IsValid() {
+		return nil, errInvalidAddr
+	}
+
+	return []byte(n.Addr.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts any form netip.ParseAddr accepts, or an empty text as null.
+func (n *Addr) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*n = Addr{}
+
+		return nil
+	}
+
+	return n.parse(string(b))
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (the unspecified, broadcast, loopback or an IPv4-mapped IPv6 address),
+// or a random IPv4 or IPv6 address.
+func (Addr) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(Addr{})
+	}
+
+	if s, ok := generateEdge(r, "0.0.0.0", "255.255.255.255", "127.0.0.1", "::", "::1", "::ffff:192.0.2.1"); ok {
+		return reflect.ValueOf(NewAddr(netip.MustParseAddr(s), true))
+	}
+
+	return reflect.ValueOf(NewAddr(generateNetipAddr(r), true))
+}
+
+var errInvalidAddr = errors.New("invalid IP address")
+
+func (n *Addr) parse(s string) error {
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	*n = NewAddr(a, true)
+
+	return nil
+}
+
+// generateNetipAddr returns a random IPv4 or IPv6 address.
+func generateNetipAddr(r *rand.Rand) netip.Addr {
+	if r.Intn(2) == 0 {
+		var b [4]byte
+		r.Read(b[:])
+
+		return netip.AddrFrom4(b)
+	}
+
+	var b [16]byte
+	r.Read(b[:])
+
+	return netip.AddrFrom16(b)
+}
@@ -0,0 +1,517 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestAddr(t *testing.T) {
+	var n nullable.Addr
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextMarshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextUnmarshaler)(nil), &n)
+}
+
+func TestAddr_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Addr]{
+		Valid: []nullable.Addr{
+			nullable.NewAddr(netip.MustParseAddr("0.0.0.0"), true),
+			nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), true),
+			nullable.NewAddr(netip.MustParseAddr("::"), true),
+			nullable.NewAddr(netip.MustParseAddr("2001:db8::1"), true),
+			nullable.NewAddr(netip.MustParseAddr("::ffff:192.0.2.1"), true),
+			nullable.NewAddr(netip.MustParseAddr("fe80::1%eth0"), true),
+		},
+		InvalidScan: []any{
+			"",
+			"192.0.2.1/24",
+			[]byte("192.0.2"),
+			int64(1),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`3221225985`),
+			[]byte(`""`),
+			[]byte(`"192.0.2.256"`),
+			[]byte(`"example.com"`),
+		},
+	})
+}
+
+func TestAddr_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Addr
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), false),
+				nullable.NewString("", false),
+			},
+			{
+				"IPv4",
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), true),
+				nullable.NewString("192.0.2.1", true),
+			},
+			{
+				"IPv6",
+				nullable.NewAddr(netip.MustParseAddr("2001:0db8:0000:0000:0000:0000:0000:0001"), true),
+				nullable.NewString("2001:db8::1", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.NullableString())
+			})
+		}
+	})
+}
+
+func TestAddr_Is4(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Addr
+			want nullable.Bool
+		}{
+			{
+				"null",
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), false),
+				nullable.NewBool(false, false),
+			},
+			{
+				"IPv4",
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), true),
+				nullable.NewBool(true, true),
+			},
+			{
+				"IPv4-mapped IPv6",
+				nullable.NewAddr(netip.MustParseAddr("::ffff:192.0.2.1"), true),
+				nullable.NewBool(false, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Is4())
+			})
+		}
+	})
+}
+
+func TestAddr_Unmap(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Addr
+			want nullable.Addr
+		}{
+			{
+				"null",
+				nullable.NewAddr(netip.MustParseAddr("::ffff:192.0.2.1"), false),
+				nullable.Addr{},
+			},
+			{
+				"IPv4-mapped IPv6",
+				nullable.NewAddr(netip.MustParseAddr("::ffff:192.0.2.1"), true),
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), true),
+			},
+			{
+				"IPv6",
+				nullable.NewAddr(netip.MustParseAddr("2001:db8::1"), true),
+				nullable.NewAddr(netip.MustParseAddr("2001:db8::1"), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Unmap())
+			})
+		}
+	})
+}
+
+func TestAddr_Value(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := nullable.NewAddr(netip.Addr{}, true).Value()
+		require.ErrorContains(t, err, "invalid IP address")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Addr
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewAddr(netip.Addr{}, false),
+				nil,
+			},
+			{
+				"IPv4",
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), true),
+				"192.0.2.1",
+			},
+			{
+				"IPv6",
+				nullable.NewAddr(netip.MustParseAddr("2001:db8::1"), true),
+				"2001:db8::1",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestAddr_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"int64",
+				int64(1),
+				"unsupported source type: int64",
+			},
+			{
+				"string: empty",
+				"",
+				"invalid source",
+			},
+			{
+				"string: prefix",
+				"192.0.2.1/24",
+				"invalid source",
+			},
+			{
+				"[]byte: 5 bytes",
+				[]byte{192, 0, 2, 1, 0},
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Addr
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.Addr
+		}{
+			{
+				"nil",
+				nil,
+				nullable.Addr{},
+			},
+			{
+				"string",
+				"192.0.2.1",
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), true),
+			},
+			{
+				"[]byte: text",
+				[]byte("2001:db8::1"),
+				nullable.NewAddr(netip.MustParseAddr("2001:db8::1"), true),
+			},
+			{
+				"[]byte: text of 4 bytes",
+				[]byte("1::2"),
+				nullable.NewAddr(netip.MustParseAddr("1::2"), true),
+			},
+			{
+				"[]byte: 4 bytes",
+				[]byte{192, 0, 2, 1},
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), true),
+			},
+			{
+				"[]byte: 16 bytes",
+				[]byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+				nullable.NewAddr(netip.MustParseAddr("2001:db8::1"), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Addr
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestAddr_MarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := nullable.NewAddr(netip.Addr{}, true).MarshalJSON()
+		require.ErrorContains(t, err, "invalid IP address")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Addr
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewAddr(netip.Addr{}, false),
+				[]byte(`null`),
+			},
+			{
+				"IPv4",
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), true),
+				[]byte(`"192.0.2.1"`),
+			},
+			{
+				"IPv6",
+				nullable.NewAddr(netip.MustParseAddr("2001:db8::1"), true),
+				[]byte(`"2001:db8::1"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestAddr_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"number",
+				[]byte(`3221225985`),
+				"cannot unmarshal number",
+			},
+			{
+				"string: empty",
+				[]byte(`""`),
+				"invalid source",
+			},
+			{
+				"string: out of range",
+				[]byte(`"192.0.2.256"`),
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Addr
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Addr
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.Addr{},
+			},
+			{
+				"IPv4",
+				[]byte(`"192.0.2.1"`),
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), true),
+			},
+			{
+				"IPv6",
+				[]byte(`"2001:DB8::1"`),
+				nullable.NewAddr(netip.MustParseAddr("2001:db8::1"), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Addr
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestAddr_MarshalText(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Addr
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewAddr(netip.Addr{}, false),
+				[]byte{},
+			},
+			{
+				"IPv4",
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), true),
+				[]byte("192.0.2.1"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := tc.in.MarshalText()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestAddr_UnmarshalText(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		var n nullable.Addr
+		err := n.UnmarshalText([]byte("192.0.2"))
+		require.ErrorContains(t, err, "invalid source")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Addr
+		}{
+			{
+				"empty",
+				[]byte{},
+				nullable.Addr{},
+			},
+			{
+				"IPv6",
+				[]byte("2001:db8::1"),
+				nullable.NewAddr(netip.MustParseAddr("2001:db8::1"), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Addr
+				err := n.UnmarshalText(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestAddr_Generate(t *testing.T) {
+	ns := generate[nullable.Addr](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Addr
+	}{
+		{
+			"null",
+			nullable.Addr{},
+		},
+		{
+			"IPv4 unspecified",
+			nullable.NewAddr(netip.MustParseAddr("0.0.0.0"), true),
+		},
+		{
+			"IPv4 broadcast",
+			nullable.NewAddr(netip.MustParseAddr("255.255.255.255"), true),
+		},
+		{
+			"IPv4 loopback",
+			nullable.NewAddr(netip.MustParseAddr("127.0.0.1"), true),
+		},
+		{
+			"IPv6 unspecified",
+			nullable.NewAddr(netip.MustParseAddr("::"), true),
+		},
+		{
+			"IPv6 loopback",
+			nullable.NewAddr(netip.MustParseAddr("::1"), true),
+		},
+		{
+			"IPv4-mapped IPv6",
+			nullable.NewAddr(netip.MustParseAddr("::ffff:192.0.2.1"), true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzAddr_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Addr](f,
+		[]byte(""),
+		[]byte("192.0.2.1"),
+		[]byte("2001:db8::1"),
+		[]byte("fe80::1%eth0"),
+		[]byte{192, 0, 2, 1},
+		[]byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+	)
+}
+
+func FuzzAddr_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Addr](f,
+		[]byte(`0`),
+		[]byte(`null`),
+		[]byte(`""`),
+		[]byte(`"192.0.2.1"`),
+		[]byte(`"::ffff:192.0.2.1"`),
+	)
+}
+
+func FuzzAddr_UnmarshalText(f *testing.F) {
+	nullabletest.FuzzText[nullable.Addr](f,
+		[]byte{},
+		[]byte("192.0.2.1"),
+		[]byte("2001:db8::1"),
+		[]byte("192.0.2"),
+	)
+}
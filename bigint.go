@@ -0,0 +1,194 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+// BigInt represents a nullable *big.Int of unbounded size.
+//
+// It holds its own copy of the integer, which it never modifies:
+// NewBigInt copies its argument and the BigInt method returns a copy,
+// so that neither the caller nor copies of the BigInt can mutate each other's value.
+type BigInt struct {
+	x     *big.Int
+	Valid bool
+}
+
+// NewBigInt returns a new BigInt holding a copy of x; a nil x is taken as 0.
+func NewBigInt(x *big.Int, valid bool) BigInt {
+	if !valid {
+		return BigInt{}
+	}
+
+	if x == nil {
+		return BigInt{x: new(big.Int), Valid: true}
+	}
+
+	return BigInt{x: new(big.Int).Set(x), Valid: true}
+}
+
+// BigInt returns a copy of the value, or nil if invalid.
+func (n BigInt) BigInt() *big.Int {
+	if !n.Valid {
+		return nil
+	}
+
+	return new(big.Int).Set(n.bigInt())
+}
+
+// NullableString returns the value as a String in decimal.
+func (n BigInt) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(n.bigInt().String(), true)
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a decimal string, or nil if invalid.
+func (n BigInt) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.bigInt().String(), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts one of the following:
+//   - int64
+//   - uint64
+//   - string (decimal integer)
+//   - []byte (decimal integer, as NUMERIC columns return)
+//   - nil
+func (n *BigInt) Scan(src any) error {
+	if src == nil {
+		*n = BigInt{}
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case int64:
+		*n = BigInt{x: big.NewInt(v), Valid: true}
+
+		return nil
+
+	case uint64:
+		*n = BigInt{x: new(big.Int).SetUint64(v), Valid: true}
+
+		return nil
+
+	case string:
+		return n.parse(v)
+
+	case []byte:
+		return n.parse(string(v))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in decimal, or null if invalid.
+func (n BigInt) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.bigInt().String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string in decimal, a JSON number (integer) or null.
+func (n *BigInt) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = BigInt{}
+
+		return nil
+	}
+
+	if !json.Valid(b) {
+		return fmt.Errorf("invalid JSON: %s", b)
+	}
+
+	if b[0] != '"' {
+		return n.parse(string(b))
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	return n.parse(s)
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (0, ±1 or ±2^256), or a random integer of up to 320 bits.
+func (BigInt) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(BigInt{})
+	}
+
+	two256 := new(big.Int).Lsh(big.NewInt(1), 256)
+
+	if x, ok := generateEdge(r, new(big.Int), big.NewInt(1), big.NewInt(-1), two256, new(big.Int).Neg(two256)); ok {
+		return reflect.ValueOf(NewBigInt(x, true))
+	}
+
+	b := make([]byte, r.Intn(41))
+	r.Read(b)
+
+	x := new(big.Int).SetBytes(b)
+	if r.Intn(2) == 0 {
+		x.Neg(x)
+	}
+
+	return reflect.ValueOf(BigInt{x: x, Valid: true})
+}
+
+func (n BigInt) bigInt() *big.Int {
+	if n.x == nil {
+		return new(big.Int)
+	}
+
+	return n.x
+}
+
+func (n *BigInt) parse(s string) error {
+	x, err := parseBigInt(s)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	*n = BigInt{x: x, Valid: true}
+
+	return nil
+}
+
+// parseBigInt parses a decimal integer with an optional sign.
+func parseBigInt(s string) (*big.Int, error) {
+	digits := s
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		digits = s[1:]
+	}
+
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+
+	x, _ := new(big.Int).SetString(s, 10)
+
+	return x, nil
+}
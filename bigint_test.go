@@ -0,0 +1,451 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+// two256 is 2^256, beyond Uint256.
+var two256 = new(big.Int).Lsh(big.NewInt(1), 256)
+
+func TestBigInt(t *testing.T) {
+	var n nullable.BigInt
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestBigInt_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.BigInt]{
+		Valid: []nullable.BigInt{
+			nullable.NewBigInt(big.NewInt(0), true),
+			nullable.NewBigInt(big.NewInt(-1), true),
+			nullable.NewBigInt(two256, true),
+			nullable.NewBigInt(new(big.Int).Neg(two256), true),
+		},
+		InvalidScan: []any{
+			"",
+			"1.0",
+			[]byte("0x1"),
+			1.0,
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`1.0`),
+			[]byte(`1e3`),
+			[]byte(`"1_000"`),
+		},
+	})
+}
+
+func TestNewBigInt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name  string
+			in    *big.Int
+			valid bool
+			want  nullable.String
+		}{
+			{
+				"null",
+				big.NewInt(1),
+				false,
+				nullable.NewString("", false),
+			},
+			{
+				"nil",
+				nil,
+				true,
+				nullable.NewString("0", true),
+			},
+			{
+				"2^256",
+				two256,
+				true,
+				nullable.NewString("115792089237316195423570985008687907853269984665640564039457584007913129639936", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n := nullable.NewBigInt(tc.in, tc.valid)
+				require.Equal(t, tc.want, n.NullableString())
+			})
+		}
+	})
+
+	t.Run("success: copies x", func(t *testing.T) {
+		x := big.NewInt(1)
+		n := nullable.NewBigInt(x, true)
+
+		x.SetInt64(0)
+
+		require.Equal(t, big.NewInt(1), n.BigInt())
+	})
+}
+
+func TestBigInt_BigInt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.BigInt
+			want *big.Int
+		}{
+			{
+				"null",
+				nullable.NewBigInt(big.NewInt(1), false),
+				nil,
+			},
+			{
+				"zero value with Valid set",
+				nullable.BigInt{Valid: true},
+				new(big.Int),
+			},
+			{
+				"2^256",
+				nullable.NewBigInt(two256, true),
+				two256,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.BigInt())
+			})
+		}
+	})
+
+	t.Run("success: returns a copy", func(t *testing.T) {
+		n := nullable.NewBigInt(big.NewInt(1), true)
+		m := n
+
+		n.BigInt().SetInt64(0)
+
+		require.Equal(t, big.NewInt(1), n.BigInt())
+		require.Equal(t, big.NewInt(1), m.BigInt())
+	})
+}
+
+func TestBigInt_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.BigInt
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewBigInt(nil, false),
+				nil,
+			},
+			{
+				"zero",
+				nullable.NewBigInt(nil, true),
+				"0",
+			},
+			{
+				"-2^256",
+				nullable.NewBigInt(new(big.Int).Neg(two256), true),
+				"-115792089237316195423570985008687907853269984665640564039457584007913129639936",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestBigInt_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"float64",
+				1.0,
+				"unsupported source type: float64",
+			},
+			{
+				"string: empty",
+				"",
+				`invalid source: invalid integer ""`,
+			},
+			{
+				"string: sign only",
+				"-",
+				"invalid integer",
+			},
+			{
+				"string: double sign",
+				"-+1",
+				"invalid integer",
+			},
+			{
+				"[]byte: fraction",
+				[]byte("1.0"),
+				"invalid integer",
+			},
+			{
+				"[]byte: whitespace",
+				[]byte(" 1"),
+				"invalid integer",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.BigInt
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.BigInt
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewBigInt(nil, false),
+			},
+			{
+				"int64",
+				int64(math.MinInt64),
+				nullable.NewBigInt(big.NewInt(math.MinInt64), true),
+			},
+			{
+				"uint64",
+				uint64(math.MaxUint64),
+				nullable.NewBigInt(new(big.Int).SetUint64(math.MaxUint64), true),
+			},
+			{
+				"string",
+				"+1",
+				nullable.NewBigInt(big.NewInt(1), true),
+			},
+			{
+				"[]byte",
+				[]byte("115792089237316195423570985008687907853269984665640564039457584007913129639936"),
+				nullable.NewBigInt(two256, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.BigInt
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.NullableString(), n.NullableString())
+			})
+		}
+	})
+
+	t.Run("success: leaves copies intact", func(t *testing.T) {
+		n := nullable.NewBigInt(big.NewInt(1), true)
+		m := n
+
+		require.NoError(t, n.Scan(int64(2)))
+
+		require.Equal(t, big.NewInt(2), n.BigInt())
+		require.Equal(t, big.NewInt(1), m.BigInt())
+	})
+}
+
+func TestBigInt_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.BigInt
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewBigInt(nil, false),
+				[]byte(`null`),
+			},
+			{
+				"zero",
+				nullable.NewBigInt(nil, true),
+				[]byte(`"0"`),
+			},
+			{
+				"2^256",
+				nullable.NewBigInt(two256, true),
+				[]byte(`"115792089237316195423570985008687907853269984665640564039457584007913129639936"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestBigInt_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"empty",
+				[]byte{},
+				"invalid JSON",
+			},
+			{
+				"boolean",
+				[]byte(`true`),
+				"invalid integer",
+			},
+			{
+				"number: fraction",
+				[]byte(`1.0`),
+				"invalid integer",
+			},
+			{
+				"number: exponent",
+				[]byte(`1e3`),
+				"invalid integer",
+			},
+			{
+				"string: hex",
+				[]byte(`"0x1"`),
+				"invalid integer",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.BigInt
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.BigInt
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewBigInt(nil, false),
+			},
+			{
+				"number",
+				[]byte(`-115792089237316195423570985008687907853269984665640564039457584007913129639936`),
+				nullable.NewBigInt(new(big.Int).Neg(two256), true),
+			},
+			{
+				"string",
+				[]byte(`"115792089237316195423570985008687907853269984665640564039457584007913129639936"`),
+				nullable.NewBigInt(two256, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.BigInt
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.NullableString(), n.NullableString())
+			})
+		}
+	})
+}
+
+func TestBigInt_Generate(t *testing.T) {
+	ns := generate[nullable.BigInt](t, 1000)
+
+	ss := make([]nullable.String, len(ns))
+	for i, n := range ns {
+		ss[i] = n.NullableString()
+	}
+
+	tcs := []struct {
+		name string
+		want nullable.BigInt
+	}{
+		{
+			"null",
+			nullable.NewBigInt(nil, false),
+		},
+		{
+			"0",
+			nullable.NewBigInt(big.NewInt(0), true),
+		},
+		{
+			"1",
+			nullable.NewBigInt(big.NewInt(1), true),
+		},
+		{
+			"-1",
+			nullable.NewBigInt(big.NewInt(-1), true),
+		},
+		{
+			"2^256",
+			nullable.NewBigInt(two256, true),
+		},
+		{
+			"-2^256",
+			nullable.NewBigInt(new(big.Int).Neg(two256), true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ss, tc.want.NullableString())
+		})
+	}
+}
+
+func FuzzBigInt_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.BigInt](f,
+		[]byte(""),
+		[]byte("0"),
+		[]byte("-1"),
+		[]byte("+1"),
+		[]byte("1.0"),
+		[]byte("115792089237316195423570985008687907853269984665640564039457584007913129639936"),
+	)
+}
+
+func FuzzBigInt_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.BigInt](f,
+		[]byte{},
+		[]byte(`1.0`),
+		[]byte(`null`),
+		[]byte(`-1`),
+		[]byte(`"0"`),
+		[]byte(`"115792089237316195423570985008687907853269984665640564039457584007913129639936"`),
+	)
+}
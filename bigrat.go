@@ -0,0 +1,219 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// BigRat represents a nullable *big.Rat, an exact fraction of unbounded size.
+//
+// It holds its own copy of the fraction, which it never modifies:
+// NewBigRat copies its argument and the BigRat method returns a copy,
+// so that neither the caller nor copies of the BigRat can mutate each other's value.
+//
+// It is formatted in decimal if the fraction has a finite decimal expansion, such as "-0.25",
+// or as a fraction otherwise, such as "1/3"; only the former fit NUMERIC columns.
+type BigRat struct {
+	x     *big.Rat
+	Valid bool
+}
+
+// NewBigRat returns a new BigRat holding a copy of x; a nil x is taken as 0.
+func NewBigRat(x *big.Rat, valid bool) BigRat {
+	if !valid {
+		return BigRat{}
+	}
+
+	if x == nil {
+		return BigRat{x: new(big.Rat), Valid: true}
+	}
+
+	return BigRat{x: new(big.Rat).Set(x), Valid: true}
+}
+
+// BigRat returns a copy of the value, or nil if invalid.
+func (n BigRat) BigRat() *big.Rat {
+	if !n.Valid {
+		return nil
+	}
+
+	return new(big.Rat).Set(n.bigRat())
+}
+
+// NullableString returns the value as a String in decimal, or as a fraction
+// if it has no finite decimal expansion.
+func (n BigRat) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(n.format(), true)
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a string in decimal, or as a fraction if it has no finite decimal expansion,
+// or nil if invalid.
+func (n BigRat) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.format(), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts one of the following:
+//   - int64
+//   - float64 (its shortest decimal representation)
+//   - string (decimal or fraction)
+//   - []byte (decimal or fraction, as NUMERIC columns return)
+//   - nil
+func (n *BigRat) Scan(src any) error {
+	if src == nil {
+		*n = BigRat{}
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case int64:
+		*n = BigRat{x: new(big.Rat).SetInt64(v), Valid: true}
+
+		return nil
+
+	case float64:
+		return n.parse(strconv.FormatFloat(v, 'g', -1, 64))
+
+	case string:
+		return n.parse(v)
+
+	case []byte:
+		return n.parse(string(v))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in decimal, or as a fraction if it has no finite decimal expansion,
+// or null if invalid.
+func (n BigRat) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.format())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string in decimal or as a fraction, a JSON number or null.
+func (n *BigRat) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = BigRat{}
+
+		return nil
+	}
+
+	if !json.Valid(b) {
+		return fmt.Errorf("invalid JSON: %s", b)
+	}
+
+	if b[0] != '"' {
+		return n.parse(string(b))
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	return n.parse(s)
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (0, 1, -1/2, 1/3 or 10^-18), or a random fraction.
+func (BigRat) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(BigRat{})
+	}
+
+	if x, ok := generateEdge(r,
+		new(big.Rat),
+		big.NewRat(1, 1),
+		big.NewRat(-1, 2),
+		big.NewRat(1, 3),
+		big.NewRat(1, 1_000_000_000_000_000_000),
+	); ok {
+		return reflect.ValueOf(NewBigRat(x, true))
+	}
+
+	return reflect.ValueOf(BigRat{x: big.NewRat(r.Int63()-r.Int63(), r.Int63n(1<<20)+1), Valid: true})
+}
+
+func (n BigRat) bigRat() *big.Rat {
+	if n.x == nil {
+		return new(big.Rat)
+	}
+
+	return n.x
+}
+
+func (n BigRat) format() string {
+	x := n.bigRat()
+
+	if prec, exact := x.FloatPrec(); exact {
+		return x.FloatString(prec)
+	}
+
+	return x.String()
+}
+
+func (n *BigRat) parse(s string) error {
+	x, err := parseBigRat(s)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	*n = BigRat{x: x, Valid: true}
+
+	return nil
+}
+
+// parseBigRat parses a decimal as ParseDecimal does, or a fraction of a decimal integer
+// with an optional sign and a positive decimal integer, such as "-1/3".
+func parseBigRat(s string) (*big.Rat, error) {
+	if num, den, ok := strings.Cut(s, "/"); ok {
+		a, err := parseBigInt(num)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fraction %q", s)
+		}
+
+		b, err := parseBigInt(den)
+		if err != nil || strings.ContainsAny(den, "+-") || b.Sign() == 0 {
+			return nil, fmt.Errorf("invalid fraction %q", s)
+		}
+
+		return new(big.Rat).SetFrac(a, b), nil
+	}
+
+	d, err := ParseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	x := new(big.Rat).SetInt(d.coefficient())
+	if d.Scale >= 0 {
+		return x.Quo(x, new(big.Rat).SetInt(pow10(d.Scale))), nil
+	}
+
+	return x.Mul(x, new(big.Rat).SetInt(pow10(-d.Scale))), nil
+}
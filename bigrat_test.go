@@ -0,0 +1,483 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestBigRat(t *testing.T) {
+	var n nullable.BigRat
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestBigRat_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.BigRat]{
+		Valid: []nullable.BigRat{
+			nullable.NewBigRat(new(big.Rat), true),
+			nullable.NewBigRat(big.NewRat(-1, 4), true),
+			nullable.NewBigRat(big.NewRat(1, 3), true),
+			nullable.NewBigRat(new(big.Rat).SetFrac(two256, big.NewInt(7)), true),
+		},
+		InvalidScan: []any{
+			"",
+			"1/0",
+			[]byte("NaN"),
+			math.NaN(),
+			true,
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`"1/-3"`),
+			[]byte(`1e999999999`),
+		},
+	})
+}
+
+func TestNewBigRat(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name  string
+			in    *big.Rat
+			valid bool
+			want  nullable.String
+		}{
+			{
+				"null",
+				big.NewRat(1, 3),
+				false,
+				nullable.NewString("", false),
+			},
+			{
+				"nil",
+				nil,
+				true,
+				nullable.NewString("0", true),
+			},
+			{
+				"integer",
+				big.NewRat(-6, 2),
+				true,
+				nullable.NewString("-3", true),
+			},
+			{
+				"finite decimal",
+				big.NewRat(-1, 40),
+				true,
+				nullable.NewString("-0.025", true),
+			},
+			{
+				"infinite decimal",
+				big.NewRat(2, 6),
+				true,
+				nullable.NewString("1/3", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n := nullable.NewBigRat(tc.in, tc.valid)
+				require.Equal(t, tc.want, n.NullableString())
+			})
+		}
+	})
+
+	t.Run("success: copies x", func(t *testing.T) {
+		x := big.NewRat(1, 3)
+		n := nullable.NewBigRat(x, true)
+
+		x.SetInt64(0)
+
+		require.Equal(t, big.NewRat(1, 3), n.BigRat())
+	})
+}
+
+func TestBigRat_BigRat(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.BigRat
+			want *big.Rat
+		}{
+			{
+				"null",
+				nullable.NewBigRat(big.NewRat(1, 3), false),
+				nil,
+			},
+			{
+				"zero value with Valid set",
+				nullable.BigRat{Valid: true},
+				big.NewRat(0, 1),
+			},
+			{
+				"1/3",
+				nullable.NewBigRat(big.NewRat(1, 3), true),
+				big.NewRat(1, 3),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.BigRat())
+			})
+		}
+	})
+
+	t.Run("success: returns a copy", func(t *testing.T) {
+		n := nullable.NewBigRat(big.NewRat(1, 3), true)
+		m := n
+
+		n.BigRat().SetInt64(0)
+
+		require.Equal(t, big.NewRat(1, 3), n.BigRat())
+		require.Equal(t, big.NewRat(1, 3), m.BigRat())
+	})
+}
+
+func TestBigRat_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.BigRat
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewBigRat(nil, false),
+				nil,
+			},
+			{
+				"zero",
+				nullable.NewBigRat(nil, true),
+				"0",
+			},
+			{
+				"finite decimal",
+				nullable.NewBigRat(big.NewRat(1, 1_000_000_000_000_000_000), true),
+				"0.000000000000000001",
+			},
+			{
+				"infinite decimal",
+				nullable.NewBigRat(big.NewRat(-2, 3), true),
+				"-2/3",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestBigRat_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"bool",
+				true,
+				"unsupported source type: bool",
+			},
+			{
+				"float64: NaN",
+				math.NaN(),
+				"invalid source",
+			},
+			{
+				"string: empty",
+				"",
+				"invalid source",
+			},
+			{
+				"string: zero denominator",
+				"1/0",
+				"invalid fraction",
+			},
+			{
+				"string: signed denominator",
+				"1/+3",
+				"invalid fraction",
+			},
+			{
+				"string: decimal numerator",
+				"0.5/3",
+				"invalid fraction",
+			},
+			{
+				"[]byte: huge exponent",
+				[]byte("1e999999999"),
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.BigRat
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.BigRat
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewBigRat(nil, false),
+			},
+			{
+				"int64",
+				int64(math.MinInt64),
+				nullable.NewBigRat(new(big.Rat).SetInt64(math.MinInt64), true),
+			},
+			{
+				"float64",
+				0.1,
+				nullable.NewBigRat(big.NewRat(1, 10), true),
+			},
+			{
+				"string: decimal",
+				"-0.025",
+				nullable.NewBigRat(big.NewRat(-1, 40), true),
+			},
+			{
+				"string: exponent",
+				"2.5e2",
+				nullable.NewBigRat(big.NewRat(250, 1), true),
+			},
+			{
+				"string: fraction",
+				"-2/6",
+				nullable.NewBigRat(big.NewRat(-1, 3), true),
+			},
+			{
+				"[]byte: NUMERIC",
+				[]byte("99999999999999999999.999999999999999999"),
+				nullable.NewBigRat(new(big.Rat).SetFrac(
+					new(big.Int).Sub(new(big.Int).Exp(big.NewInt(10), big.NewInt(38), nil), big.NewInt(1)),
+					new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil),
+				), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.BigRat
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.NullableString(), n.NullableString())
+			})
+		}
+	})
+
+	t.Run("success: leaves copies intact", func(t *testing.T) {
+		n := nullable.NewBigRat(big.NewRat(1, 3), true)
+		m := n
+
+		require.NoError(t, n.Scan("2/3"))
+
+		require.Equal(t, big.NewRat(2, 3), n.BigRat())
+		require.Equal(t, big.NewRat(1, 3), m.BigRat())
+	})
+}
+
+func TestBigRat_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.BigRat
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewBigRat(nil, false),
+				[]byte(`null`),
+			},
+			{
+				"finite decimal",
+				nullable.NewBigRat(big.NewRat(-1, 40), true),
+				[]byte(`"-0.025"`),
+			},
+			{
+				"infinite decimal",
+				nullable.NewBigRat(big.NewRat(1, 3), true),
+				[]byte(`"1/3"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestBigRat_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"empty",
+				[]byte{},
+				"invalid JSON",
+			},
+			{
+				"boolean",
+				[]byte(`true`),
+				"invalid source",
+			},
+			{
+				"number: huge exponent",
+				[]byte(`1e999999999`),
+				"invalid source",
+			},
+			{
+				"string: fraction of decimals",
+				[]byte(`"1/0.5"`),
+				"invalid fraction",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.BigRat
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.BigRat
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewBigRat(nil, false),
+			},
+			{
+				"number",
+				[]byte(`-2.5e-2`),
+				nullable.NewBigRat(big.NewRat(-1, 40), true),
+			},
+			{
+				"string: decimal",
+				[]byte(`"-0.025"`),
+				nullable.NewBigRat(big.NewRat(-1, 40), true),
+			},
+			{
+				"string: fraction",
+				[]byte(`"1/3"`),
+				nullable.NewBigRat(big.NewRat(1, 3), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.BigRat
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.NullableString(), n.NullableString())
+			})
+		}
+	})
+}
+
+func TestBigRat_Generate(t *testing.T) {
+	ns := generate[nullable.BigRat](t, 1000)
+
+	ss := make([]nullable.String, len(ns))
+	for i, n := range ns {
+		ss[i] = n.NullableString()
+	}
+
+	tcs := []struct {
+		name string
+		want nullable.String
+	}{
+		{
+			"null",
+			nullable.NewString("", false),
+		},
+		{
+			"0",
+			nullable.NewString("0", true),
+		},
+		{
+			"1",
+			nullable.NewString("1", true),
+		},
+		{
+			"-1/2",
+			nullable.NewString("-0.5", true),
+		},
+		{
+			"1/3",
+			nullable.NewString("1/3", true),
+		},
+		{
+			"10^-18",
+			nullable.NewString("0.000000000000000001", true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ss, tc.want)
+		})
+	}
+}
+
+func FuzzBigRat_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.BigRat](f,
+		[]byte(""),
+		[]byte("0"),
+		[]byte("-0.025"),
+		[]byte("1/3"),
+		[]byte("1/0"),
+		[]byte("2.5e2"),
+	)
+}
+
+func FuzzBigRat_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.BigRat](f,
+		[]byte{},
+		[]byte(`1e999999999`),
+		[]byte(`null`),
+		[]byte(`-2.5e-2`),
+		[]byte(`"-0.025"`),
+		[]byte(`"1/3"`),
+	)
+}
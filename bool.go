@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"math/rand"
+	"reflect"
 )
 
 // Bool represents a nullable bool wrapping sql.NullBool.
@@ -41,6 +43,35 @@ func (n Bool) BoolPtr() *bool {
 	return &n.Bool
 }
 
+// Not returns the negation of the value, or null if invalid.
+func (n Bool) Not() Bool {
+	if !n.Valid {
+		return n
+	}
+
+	return NewBool(!n.Bool, true)
+}
+
+// And returns the conjunction of the value and m in SQL three-valued logic:
+// false if either is false, otherwise null if either is invalid.
+func (n Bool) And(m Bool) Bool {
+	if (n.Valid && !n.Bool) || (m.Valid && !m.Bool) {
+		return NewBool(false, true)
+	}
+
+	if !n.Valid || !m.Valid {
+		return NewBool(false, false)
+	}
+
+	return NewBool(true, true)
+}
+
+// Or returns the disjunction of the value and m in SQL three-valued logic:
+// true if either is true, otherwise null if either is invalid.
+func (n Bool) Or(m Bool) Bool {
+	return n.Not().And(m.Not()).Not()
+}
+
 // MarshalJSON implements json.Marshaler.
 // It returns the value as a JSON boolean, or null if invalid.
 func (n Bool) MarshalJSON() ([]byte, error) {
@@ -68,3 +99,13 @@ func (n *Bool) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// Generate implements quick.Generator.
+// It returns null, or a random bool.
+func (Bool) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewBool(false, false))
+	}
+
+	return reflect.ValueOf(NewBool(r.Intn(2) == 1, true))
+}
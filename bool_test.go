@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
 )
 
 func TestBool(t *testing.T) {
@@ -19,6 +20,22 @@ func TestBool(t *testing.T) {
 	require.Implements(t, (*json.Unmarshaler)(nil), &n)
 }
 
+func TestBool_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Bool]{
+		Valid: []nullable.Bool{
+			nullable.NewBool(true, true),
+			nullable.NewBool(false, true),
+		},
+		InvalidScan: []any{
+			struct{}{},
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`0`),
+			[]byte(`"true"`),
+		},
+	})
+}
+
 func TestNewBoolFromBoolPtr(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -106,6 +123,136 @@ func TestBool_BoolPtr(t *testing.T) {
 	})
 }
 
+func TestBool_Not(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Bool
+			want nullable.Bool
+		}{
+			{
+				"null",
+				nullable.NewBool(false, false),
+				nullable.NewBool(false, false),
+			},
+			{
+				"false",
+				nullable.NewBool(false, true),
+				nullable.NewBool(true, true),
+			},
+			{
+				"true",
+				nullable.NewBool(true, true),
+				nullable.NewBool(false, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Not())
+			})
+		}
+	})
+}
+
+func TestBool_And(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			a    nullable.Bool
+			b    nullable.Bool
+			want nullable.Bool
+		}{
+			{
+				"null and null",
+				nullable.NewBool(false, false),
+				nullable.NewBool(false, false),
+				nullable.NewBool(false, false),
+			},
+			{
+				"null and false",
+				nullable.NewBool(false, false),
+				nullable.NewBool(false, true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"null and true",
+				nullable.NewBool(false, false),
+				nullable.NewBool(true, true),
+				nullable.NewBool(false, false),
+			},
+			{
+				"false and true",
+				nullable.NewBool(false, true),
+				nullable.NewBool(true, true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"true and true",
+				nullable.NewBool(true, true),
+				nullable.NewBool(true, true),
+				nullable.NewBool(true, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.a.And(tc.b))
+				require.Equal(t, tc.want, tc.b.And(tc.a))
+			})
+		}
+	})
+}
+
+func TestBool_Or(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			a    nullable.Bool
+			b    nullable.Bool
+			want nullable.Bool
+		}{
+			{
+				"null or null",
+				nullable.NewBool(false, false),
+				nullable.NewBool(false, false),
+				nullable.NewBool(false, false),
+			},
+			{
+				"null or false",
+				nullable.NewBool(false, false),
+				nullable.NewBool(false, true),
+				nullable.NewBool(false, false),
+			},
+			{
+				"null or true",
+				nullable.NewBool(false, false),
+				nullable.NewBool(true, true),
+				nullable.NewBool(true, true),
+			},
+			{
+				"false or false",
+				nullable.NewBool(false, true),
+				nullable.NewBool(false, true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"false or true",
+				nullable.NewBool(false, true),
+				nullable.NewBool(true, true),
+				nullable.NewBool(true, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.a.Or(tc.b))
+				require.Equal(t, tc.want, tc.b.Or(tc.a))
+			})
+		}
+	})
+}
+
 func TestBool_MarshalJSON(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -202,3 +349,51 @@ func TestBool_UnmarshalJSON(t *testing.T) {
 		}
 	})
 }
+
+func TestBool_Generate(t *testing.T) {
+	ns := generate[nullable.Bool](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Bool
+	}{
+		{
+			"null",
+			nullable.NewBool(false, false),
+		},
+		{
+			"false",
+			nullable.NewBool(false, true),
+		},
+		{
+			"true",
+			nullable.NewBool(true, true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzBool_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Bool](f,
+		[]byte("true"),
+		[]byte("false"),
+		[]byte("1"),
+		[]byte("0"),
+		[]byte("invalid"),
+	)
+}
+
+func FuzzBool_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Bool](f,
+		[]byte(`0`),
+		[]byte(`"true"`),
+		[]byte(`null`),
+		[]byte(`true`),
+		[]byte(`false`),
+	)
+}
@@ -0,0 +1,148 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+// Bytes represents a nullable []byte encoded in JSON as a standard base64 string, as encoding/json does for []byte.
+// A valid Bytes may be empty; only an invalid one is null.
+// BytesHex is encoded as a 0x-prefixed hex string instead.
+type Bytes struct {
+	Bytes []byte
+	Valid bool
+}
+
+// NewBytes returns a new Bytes.
+func NewBytes(b []byte, valid bool) Bytes {
+	return Bytes{
+		Bytes: b,
+		Valid: valid,
+	}
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a []byte, which is non-nil even if empty, or nil if invalid.
+func (n Bytes) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	if n.Bytes == nil {
+		return []byte{}, nil
+	}
+
+	return n.Bytes, nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts one of the following:
+//   - []byte (copied, as the driver may reuse it)
+//   - string
+//   - nil
+func (n *Bytes) Scan(src any) error {
+	if src == nil {
+		n.Bytes, n.Valid = nil, false
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case []byte:
+		n.Bytes, n.Valid = append([]byte{}, v...), true
+
+		return nil
+
+	case string:
+		n.Bytes, n.Valid = []byte(v), true
+
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in standard base64 such as "3q2+7w==", or null if invalid.
+func (n Bytes) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(base64.StdEncoding.EncodeToString(n.Bytes))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string in standard base64, padded or not, or null.
+func (n *Bytes) UnmarshalJSON(b []byte) error {
+	return n.UnmarshalJSONMaxSize(b, 0)
+}
+
+// UnmarshalJSONMaxSize is UnmarshalJSON, except that it returns an error without decoding
+// if the value would exceed maxSize bytes, so that untrusted input cannot make it allocate without bound.
+// A maxSize of 0 or less means no limit.
+// BytesMax applies a limit when decoded by encoding/json.
+func (n *Bytes) UnmarshalJSONMaxSize(b []byte, maxSize int) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.Bytes, n.Valid = nil, false
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("invalid json string: %w", err)
+	}
+
+	enc := base64.RawStdEncoding
+	if strings.HasSuffix(s, "=") {
+		enc = base64.StdEncoding
+	}
+
+	if err := checkBytesSize(base64.RawStdEncoding.DecodedLen(len(strings.TrimRight(s, "="))), maxSize); err != nil {
+		return err
+	}
+
+	v, err := enc.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	n.Bytes, n.Valid = v, true
+
+	return nil
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (empty, a NUL byte or 32 0xff bytes), or up to 64 random bytes.
+func (Bytes) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewBytes(nil, false))
+	}
+
+	if b, ok := generateEdge(r, []byte{}, []byte{0x00}, bytes.Repeat([]byte{0xff}, 32)); ok {
+		return reflect.ValueOf(NewBytes(b, true))
+	}
+
+	b := make([]byte, r.Intn(65))
+	r.Read(b)
+
+	return reflect.ValueOf(NewBytes(b, true))
+}
+
+// checkBytesSize returns an error if size exceeds a positive maxSize.
+func checkBytesSize(size int, maxSize int) error {
+	if maxSize > 0 && size > maxSize {
+		return fmt.Errorf("invalid source: exceeds the maximum of %d bytes", maxSize)
+	}
+
+	return nil
+}
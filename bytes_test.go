@@ -0,0 +1,394 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestBytes(t *testing.T) {
+	var n nullable.Bytes
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestBytes_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Bytes]{
+		Valid: []nullable.Bytes{
+			nullable.NewBytes([]byte{}, true),
+			nullable.NewBytes([]byte{0x00}, true),
+			nullable.NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+		},
+		InvalidScan: []any{
+			int64(0),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`"!"`),
+			[]byte(`[222,173,190,239]`),
+		},
+	})
+}
+
+func TestBytes_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Bytes
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewBytes(nil, false),
+				nil,
+			},
+			{
+				"empty: nil",
+				nullable.NewBytes(nil, true),
+				[]byte{},
+			},
+			{
+				"empty: non-nil",
+				nullable.NewBytes([]byte{}, true),
+				[]byte{},
+			},
+			{
+				"non-empty",
+				nullable.NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+				[]byte{0xde, 0xad, 0xbe, 0xef},
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestBytes_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"int64",
+				int64(0),
+				"unsupported source type: int64",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Bytes
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.Bytes
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewBytes(nil, false),
+			},
+			{
+				"[]byte: empty",
+				[]byte{},
+				nullable.NewBytes([]byte{}, true),
+			},
+			{
+				"[]byte: non-empty",
+				[]byte{0xde, 0xad, 0xbe, 0xef},
+				nullable.NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+			{
+				"string",
+				"m0t0k1ch1",
+				nullable.NewBytes([]byte("m0t0k1ch1"), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Bytes
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+
+	t.Run("success: copies the source", func(t *testing.T) {
+		src := []byte{0xde, 0xad, 0xbe, 0xef}
+
+		var n nullable.Bytes
+		require.NoError(t, n.Scan(src))
+
+		src[0] = 0x00
+
+		require.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, n.Bytes)
+	})
+}
+
+func TestBytes_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Bytes
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewBytes(nil, false),
+				[]byte(`null`),
+			},
+			{
+				"empty",
+				nullable.NewBytes(nil, true),
+				[]byte(`""`),
+			},
+			{
+				"non-empty",
+				nullable.NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+				[]byte(`"3q2+7w=="`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestBytes_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"boolean",
+				[]byte(`true`),
+				"invalid json string",
+			},
+			{
+				"invalid character",
+				[]byte(`"3q2+7w!="`),
+				"invalid source",
+			},
+			{
+				"URL alphabet",
+				[]byte(`"3q2-7w=="`),
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Bytes
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Bytes
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewBytes(nil, false),
+			},
+			{
+				"empty",
+				[]byte(`""`),
+				nullable.NewBytes([]byte{}, true),
+			},
+			{
+				"padded",
+				[]byte(`"3q2+7w=="`),
+				nullable.NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+			{
+				"unpadded",
+				[]byte(`"3q2+7w"`),
+				nullable.NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Bytes
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestBytes_UnmarshalJSONMaxSize(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name    string
+			maxSize int
+			in      []byte
+			want    string
+		}{
+			{
+				"padded",
+				3,
+				[]byte(`"3q2+7w=="`),
+				"exceeds the maximum of 3 bytes",
+			},
+			{
+				"unpadded",
+				1,
+				[]byte(`"AAAA"`),
+				"exceeds the maximum of 1 bytes",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Bytes
+				err := n.UnmarshalJSONMaxSize(tc.in, tc.maxSize)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name    string
+			maxSize int
+			in      []byte
+			want    nullable.Bytes
+		}{
+			{
+				"null",
+				1,
+				[]byte(`null`),
+				nullable.NewBytes(nil, false),
+			},
+			{
+				"no limit",
+				0,
+				[]byte(`"3q2+7w=="`),
+				nullable.NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+			{
+				"padded: max size",
+				4,
+				[]byte(`"3q2+7w=="`),
+				nullable.NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+			{
+				"unpadded: max size",
+				4,
+				[]byte(`"3q2+7w"`),
+				nullable.NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+			{
+				"escaped: max size",
+				3,
+				[]byte(`"\/\/\/\/"`),
+				nullable.NewBytes([]byte{0xff, 0xff, 0xff}, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Bytes
+				err := n.UnmarshalJSONMaxSize(tc.in, tc.maxSize)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestBytes_Generate(t *testing.T) {
+	ns := generate[nullable.Bytes](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Bytes
+	}{
+		{
+			"null",
+			nullable.NewBytes(nil, false),
+		},
+		{
+			"empty",
+			nullable.NewBytes([]byte{}, true),
+		},
+		{
+			"NUL",
+			nullable.NewBytes([]byte{0x00}, true),
+		},
+		{
+			"0xff...ff",
+			nullable.NewBytes([]byte{
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			}, true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzBytes_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Bytes](f,
+		[]byte(""),
+		[]byte("\x00"),
+		[]byte("\xde\xad\xbe\xef"),
+	)
+}
+
+func FuzzBytes_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Bytes](f,
+		[]byte(`true`),
+		[]byte(`"!"`),
+		[]byte(`null`),
+		[]byte(`""`),
+		[]byte(`"3q2+7w=="`),
+		[]byte(`"3q2+7w"`),
+	)
+}
@@ -0,0 +1,89 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+// BytesHex represents a nullable []byte encoded in JSON as a 0x-prefixed hex string.
+// It is stored and scanned exactly as Bytes, and converts to and from it.
+type BytesHex Bytes
+
+// NewBytesHex returns a new BytesHex.
+func NewBytesHex(b []byte, valid bool) BytesHex {
+	return BytesHex(NewBytes(b, valid))
+}
+
+// Value implements driver.Valuer.
+// It returns the same driver.Value as Bytes.Value.
+func (n BytesHex) Value() (driver.Value, error) {
+	return Bytes(n).Value()
+}
+
+// Scan implements sql.Scanner.
+// It accepts the same sources as Bytes.Scan.
+func (n *BytesHex) Scan(src any) error {
+	return (*Bytes)(n).Scan(src)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in 0x-prefixed lowercase hex such as "0xdeadbeef", or null if invalid.
+func (n BytesHex) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal("0x" + hex.EncodeToString(n.Bytes))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string in 0x-prefixed hex of either case, or null.
+func (n *BytesHex) UnmarshalJSON(b []byte) error {
+	return n.UnmarshalJSONMaxSize(b, 0)
+}
+
+// UnmarshalJSONMaxSize is UnmarshalJSON, except that it returns an error without decoding
+// if the value would exceed maxSize bytes, as Bytes.UnmarshalJSONMaxSize does.
+// BytesHexMax applies a limit when decoded by encoding/json.
+func (n *BytesHex) UnmarshalJSONMaxSize(b []byte, maxSize int) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.Bytes, n.Valid = nil, false
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("invalid json string: %w", err)
+	}
+
+	h, ok := strings.CutPrefix(s, "0x")
+	if !ok {
+		return fmt.Errorf("invalid source: %q is not 0x-prefixed", s)
+	}
+
+	if err := checkBytesSize(hex.DecodedLen(len(h)), maxSize); err != nil {
+		return err
+	}
+
+	v, err := hex.DecodeString(h)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	n.Bytes, n.Valid = v, true
+
+	return nil
+}
+
+// Generate implements quick.Generator.
+// It returns the same values as Bytes.Generate.
+func (BytesHex) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(BytesHex(Bytes{}.Generate(r, size).Interface().(Bytes)))
+}
@@ -0,0 +1,233 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestBytesHex(t *testing.T) {
+	var n nullable.BytesHex
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestBytesHex_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.BytesHex]{
+		Valid: []nullable.BytesHex{
+			nullable.NewBytesHex([]byte{}, true),
+			nullable.NewBytesHex([]byte{0x00}, true),
+			nullable.NewBytesHex([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+		},
+		InvalidScan: []any{
+			int64(0),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`"deadbeef"`),
+			[]byte(`"0xdeadbee"`),
+			[]byte(`"3q2+7w=="`),
+		},
+	})
+}
+
+func TestBytesHex_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.BytesHex
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewBytesHex(nil, false),
+				[]byte(`null`),
+			},
+			{
+				"empty",
+				nullable.NewBytesHex(nil, true),
+				[]byte(`"0x"`),
+			},
+			{
+				"non-empty",
+				nullable.NewBytesHex([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+				[]byte(`"0xdeadbeef"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestBytesHex_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"boolean",
+				[]byte(`true`),
+				"invalid json string",
+			},
+			{
+				"no prefix",
+				[]byte(`"deadbeef"`),
+				"not 0x-prefixed",
+			},
+			{
+				"odd length",
+				[]byte(`"0xdeadbee"`),
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.BytesHex
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.BytesHex
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewBytesHex(nil, false),
+			},
+			{
+				"empty",
+				[]byte(`"0x"`),
+				nullable.NewBytesHex([]byte{}, true),
+			},
+			{
+				"lowercase",
+				[]byte(`"0xdeadbeef"`),
+				nullable.NewBytesHex([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+			{
+				"uppercase",
+				[]byte(`"0xDEADBEEF"`),
+				nullable.NewBytesHex([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.BytesHex
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestBytesHex_UnmarshalJSONMaxSize(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name    string
+			maxSize int
+			in      []byte
+			want    string
+		}{
+			{
+				"too long",
+				3,
+				[]byte(`"0xdeadbeef"`),
+				"exceeds the maximum of 3 bytes",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.BytesHex
+				err := n.UnmarshalJSONMaxSize(tc.in, tc.maxSize)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name    string
+			maxSize int
+			in      []byte
+			want    nullable.BytesHex
+		}{
+			{
+				"null",
+				1,
+				[]byte(`null`),
+				nullable.NewBytesHex(nil, false),
+			},
+			{
+				"no limit",
+				0,
+				[]byte(`"0xdeadbeef"`),
+				nullable.NewBytesHex([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+			{
+				"max size",
+				4,
+				[]byte(`"0xdeadbeef"`),
+				nullable.NewBytesHex([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+			{
+				"escaped: max size",
+				4,
+				[]byte(`"\u0030xdeadbeef"`),
+				nullable.NewBytesHex([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.BytesHex
+				err := n.UnmarshalJSONMaxSize(tc.in, tc.maxSize)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestBytesHex_Generate(t *testing.T) {
+	ns := generate[nullable.BytesHex](t, 1000)
+
+	require.Contains(t, ns, nullable.NewBytesHex(nil, false))
+	require.Contains(t, ns, nullable.NewBytesHex([]byte{}, true))
+}
+
+func FuzzBytesHex_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.BytesHex](f,
+		[]byte(`true`),
+		[]byte(`"deadbeef"`),
+		[]byte(`null`),
+		[]byte(`"0x"`),
+		[]byte(`"0xdeadbeef"`),
+		[]byte(`"0xDEADBEEF"`),
+	)
+}
@@ -0,0 +1,47 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"math/rand"
+	"reflect"
+)
+
+// BytesHexMax represents a nullable []byte of at most L's MaxBytes bytes, encoded in JSON as BytesHex is.
+// Unlike BytesHex, it rejects larger values before decoding them from JSON, as BytesMax does.
+// It is stored and scanned exactly as Bytes, without the limit, and converts to and from BytesHex.
+type BytesHexMax[L ByteLimit] BytesHex
+
+// NewBytesHexMax returns a new BytesHexMax.
+func NewBytesHexMax[L ByteLimit](b []byte, valid bool) BytesHexMax[L] {
+	return BytesHexMax[L](NewBytesHex(b, valid))
+}
+
+// Value implements driver.Valuer.
+// It returns the same driver.Value as Bytes.Value.
+func (n BytesHexMax[L]) Value() (driver.Value, error) {
+	return BytesHex(n).Value()
+}
+
+// Scan implements sql.Scanner.
+// It accepts the same sources as Bytes.Scan.
+func (n *BytesHexMax[L]) Scan(src any) error {
+	return (*BytesHex)(n).Scan(src)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the same JSON as BytesHex.MarshalJSON.
+func (n BytesHexMax[L]) MarshalJSON() ([]byte, error) {
+	return BytesHex(n).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts the same JSON as BytesHex.UnmarshalJSON, up to L's MaxBytes bytes.
+func (n *BytesHexMax[L]) UnmarshalJSON(b []byte) error {
+	return (*BytesHex)(n).UnmarshalJSONMaxSize(b, maxBytes[L]())
+}
+
+// Generate implements quick.Generator.
+// It returns the same values as BytesMax.Generate.
+func (BytesHexMax[L]) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(BytesHexMax[L](generateBytesMax(r, size, maxBytes[L]())))
+}
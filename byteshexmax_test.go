@@ -0,0 +1,120 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestBytesHexMax(t *testing.T) {
+	var n nullable.BytesHexMax[fourBytes]
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestBytesHexMax_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.BytesHexMax[fourBytes]]{
+		Valid: []nullable.BytesHexMax[fourBytes]{
+			nullable.NewBytesHexMax[fourBytes]([]byte{}, true),
+			nullable.NewBytesHexMax[fourBytes]([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+		},
+		InvalidScan: []any{
+			int64(0),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`"deadbeef"`),
+			[]byte(`"0xdeadbeef00"`),
+		},
+	})
+}
+
+func TestBytesHexMax_UnmarshalJSON(t *testing.T) {
+	type request struct {
+		Data nullable.BytesHexMax[fourBytes] `json:"data"`
+	}
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"too long",
+				[]byte(`{"data":"0xdeadbeef00"}`),
+				"exceeds the maximum of 4 bytes",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var req request
+				err := json.Unmarshal(tc.in, &req)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.BytesHexMax[fourBytes]
+		}{
+			{
+				"null",
+				[]byte(`{"data":null}`),
+				nullable.NewBytesHexMax[fourBytes](nil, false),
+			},
+			{
+				"max size",
+				[]byte(`{"data":"0xdeadbeef"}`),
+				nullable.NewBytesHexMax[fourBytes]([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+			{
+				"escaped: max size",
+				[]byte(`{"data":"\u0030xdeadbeef"}`),
+				nullable.NewBytesHexMax[fourBytes]([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var req request
+				err := json.Unmarshal(tc.in, &req)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, req.Data)
+			})
+		}
+	})
+}
+
+func TestBytesHexMax_Generate(t *testing.T) {
+	ns := generate[nullable.BytesHexMax[fourBytes]](t, 1000)
+
+	require.Contains(t, ns, nullable.NewBytesHexMax[fourBytes](nil, false))
+	require.Contains(t, ns, nullable.NewBytesHexMax[fourBytes]([]byte{}, true))
+
+	for _, n := range ns {
+		require.LessOrEqual(t, len(n.Bytes), 4)
+	}
+}
+
+func FuzzBytesHexMax_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.BytesHexMax[fourBytes]](f,
+		[]byte(`null`),
+		[]byte(`"0x"`),
+		[]byte(`"0xdeadbeef"`),
+		[]byte(`"0xdeadbeef00"`),
+		[]byte(`"\u0030xdeadbeef"`),
+	)
+}
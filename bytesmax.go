@@ -0,0 +1,76 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"math/rand"
+	"reflect"
+)
+
+// ByteLimit is implemented by the types, usually empty structs, that set the maximum size
+// of BytesMax and BytesHexMax, such as:
+//
+//	type KiB struct{}
+//
+//	func (KiB) MaxBytes() int { return 1024 }
+type ByteLimit interface {
+	// MaxBytes returns the maximum number of bytes, or 0 or less for no limit.
+	MaxBytes() int
+}
+
+// BytesMax represents a nullable []byte of at most L's MaxBytes bytes, encoded in JSON as Bytes is.
+// Unlike Bytes, it rejects larger values before decoding them from JSON,
+// so that a struct field decoded by encoding/json from untrusted input cannot make it allocate without bound.
+// It is stored and scanned exactly as Bytes, without the limit, and converts to and from it.
+type BytesMax[L ByteLimit] Bytes
+
+// NewBytesMax returns a new BytesMax.
+func NewBytesMax[L ByteLimit](b []byte, valid bool) BytesMax[L] {
+	return BytesMax[L](NewBytes(b, valid))
+}
+
+// Value implements driver.Valuer.
+// It returns the same driver.Value as Bytes.Value.
+func (n BytesMax[L]) Value() (driver.Value, error) {
+	return Bytes(n).Value()
+}
+
+// Scan implements sql.Scanner.
+// It accepts the same sources as Bytes.Scan.
+func (n *BytesMax[L]) Scan(src any) error {
+	return (*Bytes)(n).Scan(src)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the same JSON as Bytes.MarshalJSON.
+func (n BytesMax[L]) MarshalJSON() ([]byte, error) {
+	return Bytes(n).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts the same JSON as Bytes.UnmarshalJSON, up to L's MaxBytes bytes.
+func (n *BytesMax[L]) UnmarshalJSON(b []byte) error {
+	return (*Bytes)(n).UnmarshalJSONMaxSize(b, maxBytes[L]())
+}
+
+// Generate implements quick.Generator.
+// It returns the values of Bytes.Generate, truncated to L's MaxBytes bytes.
+func (BytesMax[L]) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(BytesMax[L](generateBytesMax(r, size, maxBytes[L]())))
+}
+
+// maxBytes returns the MaxBytes of the zero value of L.
+func maxBytes[L ByteLimit]() int {
+	var l L
+
+	return l.MaxBytes()
+}
+
+// generateBytesMax returns a value of Bytes.Generate truncated to a positive maxSize.
+func generateBytesMax(r *rand.Rand, size int, maxSize int) Bytes {
+	n := Bytes{}.Generate(r, size).Interface().(Bytes)
+	if maxSize > 0 && len(n.Bytes) > maxSize {
+		n.Bytes = n.Bytes[:maxSize]
+	}
+
+	return n
+}
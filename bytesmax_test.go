@@ -0,0 +1,130 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+// fourBytes limits BytesMax and BytesHexMax to 4 bytes.
+type fourBytes struct{}
+
+func (fourBytes) MaxBytes() int { return 4 }
+
+func TestBytesMax(t *testing.T) {
+	var n nullable.BytesMax[fourBytes]
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestBytesMax_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.BytesMax[fourBytes]]{
+		Valid: []nullable.BytesMax[fourBytes]{
+			nullable.NewBytesMax[fourBytes]([]byte{}, true),
+			nullable.NewBytesMax[fourBytes]([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+		},
+		InvalidScan: []any{
+			int64(0),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`"!"`),
+			[]byte(`"3q2+7wA="`),
+		},
+	})
+}
+
+func TestBytesMax_UnmarshalJSON(t *testing.T) {
+	type request struct {
+		Data nullable.BytesMax[fourBytes] `json:"data"`
+	}
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"padded",
+				[]byte(`{"data":"3q2+7wA="}`),
+				"exceeds the maximum of 4 bytes",
+			},
+			{
+				"unpadded",
+				[]byte(`{"data":"3q2+7wA"}`),
+				"exceeds the maximum of 4 bytes",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var req request
+				err := json.Unmarshal(tc.in, &req)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.BytesMax[fourBytes]
+		}{
+			{
+				"null",
+				[]byte(`{"data":null}`),
+				nullable.NewBytesMax[fourBytes](nil, false),
+			},
+			{
+				"max size",
+				[]byte(`{"data":"3q2+7w=="}`),
+				nullable.NewBytesMax[fourBytes]([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+			},
+			{
+				"escaped: max size",
+				[]byte(`{"data":"\/\/\/\/\/w=="}`),
+				nullable.NewBytesMax[fourBytes]([]byte{0xff, 0xff, 0xff, 0xff}, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var req request
+				err := json.Unmarshal(tc.in, &req)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, req.Data)
+			})
+		}
+	})
+}
+
+func TestBytesMax_Generate(t *testing.T) {
+	ns := generate[nullable.BytesMax[fourBytes]](t, 1000)
+
+	require.Contains(t, ns, nullable.NewBytesMax[fourBytes](nil, false))
+	require.Contains(t, ns, nullable.NewBytesMax[fourBytes]([]byte{}, true))
+
+	for _, n := range ns {
+		require.LessOrEqual(t, len(n.Bytes), 4)
+	}
+}
+
+func FuzzBytesMax_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.BytesMax[fourBytes]](f,
+		[]byte(`null`),
+		[]byte(`""`),
+		[]byte(`"3q2+7w=="`),
+		[]byte(`"3q2+7wA="`),
+		[]byte(`"\/\/\/\/\/w=="`),
+	)
+}
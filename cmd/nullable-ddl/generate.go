@@ -0,0 +1,391 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/m0t0k1ch1-go/nullable/v3/internal/typeutil"
+)
+
+// Dialect represents a SQL dialect.
+type Dialect string
+
+// Supported dialects.
+const (
+	DialectPostgres Dialect = "postgres"
+	DialectMySQL    Dialect = "mysql"
+	DialectSQLite   Dialect = "sqlite"
+)
+
+// columnType holds the column type of a Go type for each dialect.
+type columnType struct {
+	postgres string
+	mysql    string
+	sqlite   string
+}
+
+func (ct columnType) of(d Dialect) string {
+	switch d {
+	case DialectMySQL:
+		return ct.mysql
+	case DialectSQLite:
+		return ct.sqlite
+	default:
+		return ct.postgres
+	}
+}
+
+// nullableColumnTypes maps each nullable type to the column type matching the driver.Value it produces.
+var nullableColumnTypes = map[string]columnType{
+	"Addr":                    {"INET", "VARCHAR(45)", "TEXT"},
+	"BigInt":                  {"NUMERIC", "DECIMAL(65, 0)", "TEXT"},
+	"BigRat":                  {"TEXT", "TEXT", "TEXT"},
+	"Bool":                    {"BOOLEAN", "BOOLEAN", "INTEGER"},
+	"Bytes":                   {"BYTEA", "LONGBLOB", "BLOB"},
+	"BytesHex":                {"BYTEA", "LONGBLOB", "BLOB"},
+	"BytesHexMax":             {"BYTEA", "LONGBLOB", "BLOB"},
+	"BytesMax":                {"BYTEA", "LONGBLOB", "BLOB"},
+	"Date":                    {"DATE", "DATE", "DATE"},
+	"Decimal":                 {"NUMERIC", "DECIMAL(65, 30)", "TEXT"},
+	"DecimalNumber":           {"NUMERIC", "DECIMAL(65, 30)", "TEXT"},
+	"Duration":                {"BIGINT", "BIGINT", "INTEGER"},
+	"DurationISO8601":         {"INTERVAL", "VARCHAR(32)", "TEXT"},
+	"DurationNanoseconds":     {"BIGINT", "BIGINT", "INTEGER"},
+	"DurationSeconds":         {"BIGINT", "BIGINT", "INTEGER"},
+	"EthAddress":              {"BYTEA", "BINARY(20)", "BLOB"},
+	"EthHash":                 {"BYTEA", "BINARY(32)", "BLOB"},
+	"Float64":                 {"DOUBLE PRECISION", "DOUBLE", "REAL"},
+	"HTTPURL":                 {"TEXT", "TEXT", "TEXT"},
+	"Int8":                    {"SMALLINT", "TINYINT", "INTEGER"},
+	"Int16":                   {"SMALLINT", "SMALLINT", "INTEGER"},
+	"Int32":                   {"INTEGER", "INT", "INTEGER"},
+	"Int64":                   {"BIGINT", "BIGINT", "INTEGER"},
+	"Int256":                  {"BYTEA", "VARBINARY(32)", "BLOB"},
+	"Int256Numeric":           {"NUMERIC(78, 0)", "VARCHAR(78)", "TEXT"},
+	"JSON":                    {"JSONB", "JSON", "TEXT"},
+	"JSONOf":                  {"JSONB", "JSON", "TEXT"},
+	"Number":                  {"NUMERIC", "TEXT", "TEXT"},
+	"Prefix":                  {"CIDR", "VARCHAR(49)", "TEXT"},
+	"String":                  {"TEXT", "TEXT", "TEXT"},
+	"Time":                    timeColumnType,
+	"TimeOfDay":               {"TIME", "TIME(6)", "TEXT"},
+	"Timestamp":               {"BIGINT", "BIGINT", "INTEGER"},
+	"TimestampLenient":        {"BIGINT", "BIGINT", "INTEGER"},
+	"TimestampMillis":         {"BIGINT", "BIGINT", "INTEGER"},
+	"TimestampMillisLenient":  {"BIGINT", "BIGINT", "INTEGER"},
+	"TimestampRFC3339":        {"BIGINT", "BIGINT", "INTEGER"},
+	"TimestampRFC3339Lenient": {"BIGINT", "BIGINT", "INTEGER"},
+	"Uint8":                   {"SMALLINT", "TINYINT UNSIGNED", "INTEGER"},
+	"Uint16":                  {"INTEGER", "SMALLINT UNSIGNED", "INTEGER"},
+	"Uint32":                  {"BIGINT", "INT UNSIGNED", "INTEGER"},
+	"Uint256":                 {"BYTEA", "VARBINARY(32)", "BLOB"},
+	"Uint64":                  {"NUMERIC(20, 0)", "BIGINT UNSIGNED", "INTEGER"},
+	"URL":                     {"TEXT", "TEXT", "TEXT"},
+	"URLOf":                   {"TEXT", "TEXT", "TEXT"},
+	"UUID":                    {"UUID", "CHAR(36)", "TEXT"},
+	"UUIDBinary":              {"BYTEA", "BINARY(16)", "BLOB"},
+}
+
+// rangeColumnTypes maps each Range bound type to the PostgreSQL range type of Range.
+// Other dialects store ranges as TEXT.
+var rangeColumnTypes = map[string]string{
+	"Date":      "DATERANGE",
+	"Int32":     "INT4RANGE",
+	"Int64":     "INT8RANGE",
+	"Time":      "TSTZRANGE",
+	"Timestamp": "TSTZRANGE",
+	"Uint64":    "NUMRANGE",
+	"Uint256":   "NUMRANGE",
+}
+
+// basicColumnTypes maps each basic Go type to its column type.
+var basicColumnTypes = map[types.BasicKind]columnType{
+	types.Bool:    {"BOOLEAN", "BOOLEAN", "INTEGER"},
+	types.Int:     {"BIGINT", "BIGINT", "INTEGER"},
+	types.Int8:    {"SMALLINT", "TINYINT", "INTEGER"},
+	types.Int16:   {"SMALLINT", "SMALLINT", "INTEGER"},
+	types.Int32:   {"INTEGER", "INT", "INTEGER"},
+	types.Int64:   {"BIGINT", "BIGINT", "INTEGER"},
+	types.Uint:    {"NUMERIC(20, 0)", "BIGINT UNSIGNED", "INTEGER"},
+	types.Uint8:   {"SMALLINT", "TINYINT UNSIGNED", "INTEGER"},
+	types.Uint16:  {"INTEGER", "SMALLINT UNSIGNED", "INTEGER"},
+	types.Uint32:  {"BIGINT", "INT UNSIGNED", "INTEGER"},
+	types.Uint64:  {"NUMERIC(20, 0)", "BIGINT UNSIGNED", "INTEGER"},
+	types.Float32: {"REAL", "FLOAT", "REAL"},
+	types.Float64: {"DOUBLE PRECISION", "DOUBLE", "REAL"},
+	types.String:  {"TEXT", "TEXT", "TEXT"},
+}
+
+// sqlNullColumnTypes maps each database/sql.Null* type to its column type.
+var sqlNullColumnTypes = map[string]columnType{
+	"NullBool":    basicColumnTypes[types.Bool],
+	"NullByte":    basicColumnTypes[types.Uint8],
+	"NullFloat64": basicColumnTypes[types.Float64],
+	"NullInt16":   basicColumnTypes[types.Int16],
+	"NullInt32":   basicColumnTypes[types.Int32],
+	"NullInt64":   basicColumnTypes[types.Int64],
+	"NullString":  basicColumnTypes[types.String],
+	"NullTime":    timeColumnType,
+}
+
+var (
+	bytesColumnType = columnType{"BYTEA", "BLOB", "BLOB"}
+	timeColumnType  = columnType{"TIMESTAMPTZ", "DATETIME(6)", "TIMESTAMP"}
+)
+
+// Generate loads the packages matching the patterns and returns a CREATE TABLE statement
+// for each struct with nullable fields, or for each struct named in typeNames if it is not empty.
+func Generate(dialect Dialect, typeNames []string, patterns []string) ([]byte, error) {
+	if !slices.Contains([]Dialect{DialectPostgres, DialectMySQL, DialectSQLite}, dialect) {
+		return nil, fmt.Errorf("unsupported dialect: %s", dialect)
+	}
+
+	pkgs, err := typeutil.LoadPackages(packages.NeedName|packages.NeedTypes, patterns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var objs []*types.TypeName
+	if len(typeNames) == 0 {
+		for _, pkg := range pkgs {
+			objs = append(objs, typeutil.StructsWithNullableFields(pkg.Types)...)
+		}
+	} else {
+		for _, name := range typeNames {
+			obj, err := lookupStruct(pkgs, name)
+			if err != nil {
+				return nil, err
+			}
+
+			objs = append(objs, obj)
+		}
+	}
+
+	var buf bytes.Buffer
+	for i, obj := range objs {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+
+		if err := writeCreateTable(&buf, dialect, obj); err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", obj.Pkg().Path(), obj.Name(), err)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func lookupStruct(pkgs []*packages.Package, name string) (*types.TypeName, error) {
+	for _, pkg := range pkgs {
+		obj, ok := pkg.Types.Scope().Lookup(name).(*types.TypeName)
+		if !ok {
+			continue
+		}
+
+		if _, ok := obj.Type().Underlying().(*types.Struct); !ok {
+			return nil, fmt.Errorf("%s.%s is not a struct", pkg.PkgPath, name)
+		}
+
+		return obj, nil
+	}
+
+	return nil, fmt.Errorf("type not found: %s", name)
+}
+
+type column struct {
+	name     string
+	typ      string
+	nullable bool
+}
+
+func writeCreateTable(buf *bytes.Buffer, dialect Dialect, obj *types.TypeName) error {
+	var cols []column
+	if err := collectColumns(obj.Type().Underlying().(*types.Struct), dialect, &cols); err != nil {
+		return err
+	}
+
+	if len(cols) == 0 {
+		return fmt.Errorf("no columns")
+	}
+
+	fmt.Fprintf(buf, "CREATE TABLE %s (\n", quoteIdent(dialect, snakeCase(obj.Name())))
+	for i, col := range cols {
+		null := "NOT NULL"
+		if col.nullable {
+			null = "NULL"
+		}
+
+		fmt.Fprintf(buf, "  %s %s %s", quoteIdent(dialect, col.name), col.typ, null)
+		if i < len(cols)-1 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString(");\n")
+
+	return nil
+}
+
+func collectColumns(st *types.Struct, dialect Dialect, cols *[]column) error {
+	for i := range st.NumFields() {
+		v := st.Field(i)
+
+		tag := reflect.StructTag(st.Tag(i)).Get("db")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		if v.Anonymous() && name == "" {
+			t := v.Type()
+			if p, ok := t.(*types.Pointer); ok {
+				t = p.Elem()
+			}
+
+			if est, ok := t.Underlying().(*types.Struct); ok && !typeutil.IsNullable(t) && !isSQLNull(t) {
+				if err := collectColumns(est, dialect, cols); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if !v.Exported() {
+			continue
+		}
+
+		if name == "" {
+			name = snakeCase(v.Name())
+		}
+
+		ct, nullable, err := columnTypeOf(v.Type())
+		if err != nil {
+			return fmt.Errorf("field %s: %w", v.Name(), err)
+		}
+
+		*cols = append(*cols, column{
+			name:     name,
+			typ:      ct.of(dialect),
+			nullable: nullable,
+		})
+	}
+
+	return nil
+}
+
+// columnTypeOf returns the column type of t and whether the column is nullable.
+func columnTypeOf(t types.Type) (columnType, bool, error) {
+	if name, ok := typeutil.NullableName(t); ok {
+		if name == "Range" {
+			return rangeColumnTypeOf(t)
+		}
+
+		ct, ok := nullableColumnTypes[name]
+		if !ok {
+			return columnType{}, false, fmt.Errorf("unsupported nullable type: %s", name)
+		}
+
+		return ct, true, nil
+	}
+
+	if p, ok := t.(*types.Pointer); ok {
+		ct, _, err := columnTypeOf(p.Elem())
+		if err != nil {
+			return columnType{}, false, err
+		}
+
+		return ct, true, nil
+	}
+
+	if named, ok := types.Unalias(t).(*types.Named); ok {
+		obj := named.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "database/sql" {
+			if ct, ok := sqlNullColumnTypes[obj.Name()]; ok {
+				return ct, true, nil
+			}
+
+			if obj.Name() == "Null" && named.TypeArgs().Len() == 1 {
+				ct, _, err := columnTypeOf(named.TypeArgs().At(0))
+				if err != nil {
+					return columnType{}, false, err
+				}
+
+				return ct, true, nil
+			}
+		}
+	}
+
+	if typeutil.IsNamed(t, "time", "Time") {
+		return timeColumnType, false, nil
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		if ct, ok := basicColumnTypes[u.Kind()]; ok {
+			return ct, false, nil
+		}
+	case *types.Slice:
+		if b, ok := u.Elem().Underlying().(*types.Basic); ok && b.Kind() == types.Byte {
+			return bytesColumnType, false, nil
+		}
+	}
+
+	return columnType{}, false, fmt.Errorf("unsupported type: %s", t)
+}
+
+// rangeColumnTypeOf returns the column type of the nullable Range t.
+func rangeColumnTypeOf(t types.Type) (columnType, bool, error) {
+	bound, _ := typeutil.NullableName(types.Unalias(t).(*types.Named).TypeArgs().At(0))
+
+	pg, ok := rangeColumnTypes[bound]
+	if !ok {
+		return columnType{}, false, fmt.Errorf("unsupported range bound type: %s", bound)
+	}
+
+	return columnType{pg, "TEXT", "TEXT"}, true, nil
+}
+
+func isSQLNull(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == "database/sql" && strings.HasPrefix(obj.Name(), "Null")
+}
+
+func quoteIdent(dialect Dialect, name string) string {
+	if dialect == DialectMySQL {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// snakeCase converts a Go identifier such as "TxHash" or "UserID" to snake case.
+func snakeCase(s string) string {
+	rs := []rune(s)
+
+	var sb strings.Builder
+	for i, r := range rs {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := rs[i-1]
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				(unicode.IsUpper(prev) && i+1 < len(rs) && unicode.IsLower(rs[i+1])) {
+				sb.WriteRune('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
+}
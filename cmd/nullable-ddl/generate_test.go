@@ -0,0 +1,173 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name      string
+			dialect   Dialect
+			typeNames []string
+			want      string
+		}{
+			{
+				"unsupported dialect",
+				Dialect("oracle"),
+				nil,
+				"unsupported dialect",
+			},
+			{
+				"type not found",
+				DialectPostgres,
+				[]string{"Missing"},
+				"type not found",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := Generate(tc.dialect, tc.typeNames, []string{"./testdata/rows"})
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name      string
+			dialect   Dialect
+			typeNames []string
+			want      string
+		}{
+			{
+				"postgres",
+				DialectPostgres,
+				nil,
+				`CREATE TABLE "token_transfer" (
+  "id" BIGINT NOT NULL,
+  "created_at" TIMESTAMPTZ NOT NULL,
+  "tx_hash" BYTEA NULL,
+  "from_address" BYTEA NULL,
+  "to_address" BYTEA NULL,
+  "amount" BYTEA NULL,
+  "block_number" NUMERIC(20, 0) NULL,
+  "log_index" INTEGER NOT NULL,
+  "memo" TEXT NULL,
+  "fee" BIGINT NULL,
+  "confirmed" BOOLEAN NULL,
+  "price" DOUBLE PRECISION NULL,
+  "payload" BYTEA NOT NULL
+);
+
+CREATE TABLE "account" (
+  "address" TEXT NOT NULL,
+  "website" TEXT NULL,
+  "nonce" INTEGER NULL,
+  "balance" BIGINT NULL,
+  "seen_at" BIGINT NULL,
+  "login_at" TIMESTAMPTZ NULL,
+  "birthday" DATE NULL,
+  "opens_at" TIME NULL,
+  "deleted_at" TIMESTAMPTZ NULL,
+  "tag" TEXT NULL
+);
+
+CREATE TABLE "reservation" (
+  "period" TSTZRANGE NULL,
+  "days" DATERANGE NULL,
+  "seats" INT4RANGE NULL,
+  "blocks" NUMRANGE NULL,
+  "version" INT8RANGE NULL,
+  "meta" JSONB NULL
+);
+`,
+			},
+			{
+				"mysql",
+				DialectMySQL,
+				[]string{"TokenTransfer"},
+				"CREATE TABLE `token_transfer` (\n" +
+					"  `id` BIGINT NOT NULL,\n" +
+					"  `created_at` DATETIME(6) NOT NULL,\n" +
+					"  `tx_hash` BINARY(32) NULL,\n" +
+					"  `from_address` BINARY(20) NULL,\n" +
+					"  `to_address` BINARY(20) NULL,\n" +
+					"  `amount` VARBINARY(32) NULL,\n" +
+					"  `block_number` BIGINT UNSIGNED NULL,\n" +
+					"  `log_index` INT NOT NULL,\n" +
+					"  `memo` TEXT NULL,\n" +
+					"  `fee` BIGINT NULL,\n" +
+					"  `confirmed` BOOLEAN NULL,\n" +
+					"  `price` DOUBLE NULL,\n" +
+					"  `payload` BLOB NOT NULL\n" +
+					");\n",
+			},
+			{
+				"sqlite",
+				DialectSQLite,
+				[]string{"Account"},
+				`CREATE TABLE "account" (
+  "address" TEXT NOT NULL,
+  "website" TEXT NULL,
+  "nonce" INTEGER NULL,
+  "balance" INTEGER NULL,
+  "seen_at" INTEGER NULL,
+  "login_at" TIMESTAMP NULL,
+  "birthday" DATE NULL,
+  "opens_at" TEXT NULL,
+  "deleted_at" TIMESTAMP NULL,
+  "tag" TEXT NULL
+);
+`,
+			},
+			{
+				"sqlite: generic",
+				DialectSQLite,
+				[]string{"Reservation"},
+				`CREATE TABLE "reservation" (
+  "period" TEXT NULL,
+  "days" TEXT NULL,
+  "seats" TEXT NULL,
+  "blocks" TEXT NULL,
+  "version" TEXT NULL,
+  "meta" TEXT NULL
+);
+`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := Generate(tc.dialect, tc.typeNames, []string{"./testdata/rows"})
+				require.NoError(t, err)
+				require.Equal(t, tc.want, string(b))
+			})
+		}
+	})
+}
+
+func TestSnakeCase(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			in   string
+			want string
+		}{
+			{"ID", "id"},
+			{"UserID", "user_id"},
+			{"TxHash", "tx_hash"},
+			{"HTTPURL", "httpurl"},
+			{"HTTPServer", "http_server"},
+			{"Block2Number", "block2_number"},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.in, func(t *testing.T) {
+				require.Equal(t, tc.want, snakeCase(tc.in))
+			})
+		}
+	})
+}
@@ -0,0 +1,58 @@
+// Command nullable-ddl generates CREATE TABLE statements for Go structs with nullable fields.
+//
+// Usage:
+//
+//	nullable-ddl -dialect postgres|mysql|sqlite [-type T1,T2] [-o file] [packages]
+//
+// Each struct becomes a table named after the snake_cased type name, and each
+// field becomes a column named after its db tag (or the snake_cased field name).
+// Nullable, pointer and sql.Null* fields are declared NULL; any other field is
+// declared NOT NULL. Column types follow the driver.Value each type produces.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	dialect := flag.String("dialect", "postgres", "SQL dialect: postgres, mysql or sqlite")
+	typeNames := flag.String("type", "", "comma-separated list of type names (default: all structs with nullable fields)")
+	out := flag.String("o", "", "output file (default: stdout)")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: nullable-ddl -dialect postgres|mysql|sqlite [-type T1,T2] [-o file] [packages]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+
+	var types []string
+	if *typeNames != "" {
+		types = strings.Split(*typeNames, ",")
+	}
+
+	if err := run(Dialect(*dialect), types, patterns, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "nullable-ddl:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dialect Dialect, types []string, patterns []string, out string) error {
+	b, err := Generate(dialect, types, patterns)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err := os.Stdout.Write(b)
+		return err
+	}
+
+	return os.WriteFile(out, b, 0o644)
+}
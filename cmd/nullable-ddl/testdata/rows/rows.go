@@ -0,0 +1,56 @@
+package rows
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+)
+
+type Model struct {
+	ID        int64     `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type TokenTransfer struct {
+	Model
+	TxHash      nullable.EthHash
+	From        nullable.EthAddress  `db:"from_address"`
+	To          *nullable.EthAddress `db:"to_address"`
+	Amount      nullable.Uint256
+	BlockNumber nullable.Uint64
+	LogIndex    int32
+	Memo        nullable.String
+	Fee         sql.NullInt64
+	Confirmed   nullable.Bool
+	Price       nullable.Float64
+	Payload     []byte
+	Ignored     string `db:"-"`
+	internal    string
+}
+
+type Account struct {
+	Address   string
+	Website   nullable.HTTPURL
+	Nonce     nullable.Int32
+	Balance   nullable.Int64
+	SeenAt    nullable.Timestamp
+	LoginAt   nullable.Time
+	Birthday  nullable.Date
+	OpensAt   nullable.TimeOfDay
+	DeletedAt *time.Time
+	Tag       sql.Null[string]
+}
+
+type Plain struct {
+	Name string
+}
+
+type Reservation struct {
+	Period  nullable.Range[nullable.Time]
+	Days    nullable.Range[nullable.Date]
+	Seats   nullable.Range[nullable.Int32]
+	Blocks  nullable.Range[nullable.Uint64]
+	Version nullable.Range[nullable.Int64]
+	Meta    nullable.JSONOf[map[string]string]
+}
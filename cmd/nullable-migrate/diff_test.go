@@ -0,0 +1,43 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			old  string
+			new  string
+			want string
+		}{
+			{
+				"no changes",
+				"a\nb\n",
+				"a\nb\n",
+				"--- f.go\n+++ f.go\n",
+			},
+			{
+				"replace",
+				"a\nb\nc\n",
+				"a\nx\nc\n",
+				"--- f.go\n+++ f.go\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n",
+			},
+			{
+				"separate hunks",
+				"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n",
+				"0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+				"--- f.go\n+++ f.go\n@@ -1,3 +1,4 @@\n+0\n 1\n 2\n 3\n@@ -7,4 +8,3 @@\n 7\n 8\n 9\n-10\n",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, unifiedDiff("f.go", []byte(tc.old), []byte(tc.new)))
+			})
+		}
+	})
+}
@@ -0,0 +1,79 @@
+// Command nullable-migrate rewrites database/sql.Null* and pointer struct fields to nullable types.
+//
+// Usage:
+//
+//	nullable-migrate [-w] [packages]
+//
+// It converts fields of the following types, along with their usages
+// (composite literals, assignments, nil checks, dereferences and pointer uses):
+//
+//	sql.NullBool, *bool            -> nullable.Bool
+//	sql.NullFloat64, *float64      -> nullable.Float64
+//	*int8                          -> nullable.Int8
+//	*int16                         -> nullable.Int16
+//	sql.NullInt32, *int32          -> nullable.Int32
+//	sql.NullInt64, *int64          -> nullable.Int64
+//	sql.NullString, *string        -> nullable.String
+//	*uint8                         -> nullable.Uint8
+//	*uint16                        -> nullable.Uint16
+//	*uint32                        -> nullable.Uint32
+//	*uint64                        -> nullable.Uint64
+//	*time.Time                     -> nullable.Timestamp
+//	*common.Address                -> nullable.EthAddress
+//	*common.Hash                   -> nullable.EthHash
+//
+// By default, it prints the gofmt'd changes as a unified diff; with -w, it writes them
+// to the files instead. Sites it could not convert are reported on stderr.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	write := flag.Bool("w", false, "write the changes to the files instead of printing a diff")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: nullable-migrate [-w] [packages]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+
+	if err := run(patterns, *write); err != nil {
+		fmt.Fprintln(os.Stderr, "nullable-migrate:", err)
+		os.Exit(1)
+	}
+}
+
+func run(patterns []string, write bool) error {
+	res, err := Migrate(patterns)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range res.Files {
+		if write {
+			if err := os.WriteFile(f.Path, f.New, 0o644); err != nil {
+				return err
+			}
+			continue
+		}
+
+		fmt.Print(unifiedDiff(f.Path, f.Old, f.New))
+	}
+
+	if len(res.Issues) > 0 {
+		fmt.Fprintf(os.Stderr, "%d site(s) could not be converted:\n", len(res.Issues))
+		for _, issue := range res.Issues {
+			fmt.Fprintln(os.Stderr, issue)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,670 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/m0t0k1ch1-go/nullable/v3/internal/typeutil"
+)
+
+const timeutilPkgPath = "github.com/m0t0k1ch1-go/timeutil/v5"
+
+// target describes the nullable type a field is migrated to.
+type target struct {
+	// name is the nullable type name, e.g. "Int64".
+	name string
+
+	// payload is the name of the payload field, e.g. "Int64".
+	payload string
+
+	// zero is the Go expression of the zero payload, e.g. "0".
+	zero string
+
+	// ptrCtor and ptrAccessor are the pointer conversions, if the type has them.
+	ptrCtor     string
+	ptrAccessor string
+}
+
+var (
+	targetBool       = target{"Bool", "Bool", "false", "NewBoolFromBoolPtr", "BoolPtr"}
+	targetFloat64    = target{"Float64", "Float64", "0", "NewFloat64FromFloat64Ptr", "Float64Ptr"}
+	targetInt8       = target{"Int8", "Int8", "0", "NewInt8FromInt8Ptr", "Int8Ptr"}
+	targetInt16      = target{"Int16", "Int16", "0", "NewInt16FromInt16Ptr", "Int16Ptr"}
+	targetInt32      = target{"Int32", "Int32", "0", "NewInt32FromInt32Ptr", "Int32Ptr"}
+	targetInt64      = target{"Int64", "Int64", "0", "NewInt64FromInt64Ptr", "Int64Ptr"}
+	targetString     = target{"String", "String", `""`, "NewStringFromStringPtr", "StringPtr"}
+	targetUint8      = target{"Uint8", "Uint8", "0", "NewUint8FromUint8Ptr", "Uint8Ptr"}
+	targetUint16     = target{"Uint16", "Uint16", "0", "NewUint16FromUint16Ptr", "Uint16Ptr"}
+	targetUint32     = target{"Uint32", "Uint32", "0", "NewUint32FromUint32Ptr", "Uint32Ptr"}
+	targetUint64     = target{"Uint64", "Uint64", "0", "NewUint64FromUint64Ptr", "Uint64Ptr"}
+	targetTimestamp  = target{name: "Timestamp", payload: "Timestamp"}
+	targetEthAddress = target{name: "EthAddress", payload: "EthAddress"}
+	targetEthHash    = target{name: "EthHash", payload: "EthHash"}
+)
+
+// sqlNullTargets maps each database/sql.Null* type to its nullable counterpart,
+// which embeds it.
+var sqlNullTargets = map[string]target{
+	"NullBool":    targetBool,
+	"NullFloat64": targetFloat64,
+	"NullInt32":   targetInt32,
+	"NullInt64":   targetInt64,
+	"NullString":  targetString,
+}
+
+// basicPtrTargets maps the element of each supported pointer-to-basic type to its nullable counterpart.
+var basicPtrTargets = map[types.BasicKind]target{
+	types.Bool:    targetBool,
+	types.Float64: targetFloat64,
+	types.Int8:    targetInt8,
+	types.Int16:   targetInt16,
+	types.Int32:   targetInt32,
+	types.Int64:   targetInt64,
+	types.String:  targetString,
+	types.Uint8:   targetUint8,
+	types.Uint16:  targetUint16,
+	types.Uint32:  targetUint32,
+	types.Uint64:  targetUint64,
+}
+
+// namedPtrTargets maps the element of each supported pointer-to-named type to its nullable counterpart.
+var namedPtrTargets = map[string]target{
+	"time.Time": targetTimestamp,
+	"github.com/ethereum/go-ethereum/common.Address": targetEthAddress,
+	"github.com/ethereum/go-ethereum/common.Hash":    targetEthHash,
+}
+
+type fieldKind int
+
+const (
+	kindSQLNull fieldKind = iota
+	kindPtr
+)
+
+// field is a struct field to migrate.
+type field struct {
+	kind   fieldKind
+	target target
+
+	// sqlNull is the name of the embedded database/sql.Null* type, for kindSQLNull.
+	sqlNull string
+}
+
+// Issue represents a site that could not be converted.
+type Issue struct {
+	Pos     token.Position
+	Message string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s", i.Pos, i.Message)
+}
+
+// FileChange represents the migrated content of a file.
+type FileChange struct {
+	Path string
+	Old  []byte
+	New  []byte
+}
+
+// Result represents the result of a migration.
+type Result struct {
+	Files  []FileChange
+	Issues []Issue
+}
+
+// edit replaces the bytes in [start, end) of a file with text.
+type edit struct {
+	start, end int
+	text       string
+}
+
+type migrator struct {
+	fset *token.FileSet
+	// declared holds the migrated fields by position, since a package and
+	// its test variant are type-checked separately.
+	declared map[token.Position]field
+	edits    map[string][]edit
+	issues   []Issue
+	seen     map[token.Position]bool
+}
+
+// Migrate rewrites the struct fields declared in the packages matching the patterns,
+// and their usages, to nullable types.
+func Migrate(patterns []string) (*Result, error) {
+	cfg := &packages.Config{
+		Mode:  packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+		Tests: true,
+	}
+
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load packages: %w", err)
+	}
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("failed to load package %s: %w", pkg.PkgPath, pkg.Errors[0])
+		}
+	}
+
+	m := &migrator{
+		declared: map[token.Position]field{},
+		edits:    map[string][]edit{},
+		seen:     map[token.Position]bool{},
+	}
+	if len(pkgs) > 0 {
+		m.fset = pkgs[0].Fset
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Syntax {
+			if ast.IsGenerated(file) {
+				continue
+			}
+
+			m.collectFields(pkg, file)
+		}
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Syntax {
+			if ast.IsGenerated(file) {
+				continue
+			}
+
+			m.rewriteUses(pkg, file)
+		}
+	}
+
+	res := &Result{}
+	for path, edits := range m.edits {
+		change, err := m.apply(path, edits)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Files = append(res.Files, change)
+	}
+	slices.SortFunc(res.Files, func(a, b FileChange) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+
+	res.Issues = m.issues
+	slices.SortFunc(res.Issues, func(a, b Issue) int {
+		return cmp.Or(
+			cmp.Compare(a.Pos.Filename, b.Pos.Filename),
+			cmp.Compare(a.Pos.Offset, b.Pos.Offset),
+		)
+	})
+
+	return res, nil
+}
+
+// collectFields records the struct fields of file that can be migrated and rewrites their types.
+func (m *migrator) collectFields(pkg *packages.Package, file *ast.File) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		st, ok := n.(*ast.StructType)
+		if !ok {
+			return true
+		}
+
+		for _, f := range st.Fields.List {
+			t := pkg.TypesInfo.TypeOf(f.Type)
+			if t == nil {
+				continue
+			}
+
+			fld, ok := fieldOf(t)
+			if !ok {
+				continue
+			}
+
+			if len(f.Names) == 0 {
+				m.report(f.Pos(), "embedded %s field cannot be migrated", types.TypeString(t, types.RelativeTo(pkg.Types)))
+				continue
+			}
+
+			for _, name := range f.Names {
+				obj, ok := pkg.TypesInfo.Defs[name].(*types.Var)
+				if !ok {
+					continue
+				}
+
+				m.declared[m.fset.Position(obj.Pos())] = fld
+			}
+
+			m.addEdit(f.Type.Pos(), f.Type.End(), "nullable."+fld.target.name)
+		}
+
+		return true
+	})
+}
+
+// fieldOf returns the migration of a field of type t, if it is supported.
+func fieldOf(t types.Type) (field, bool) {
+	if named, ok := types.Unalias(t).(*types.Named); ok {
+		obj := named.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "database/sql" {
+			if tgt, ok := sqlNullTargets[obj.Name()]; ok {
+				return field{kind: kindSQLNull, target: tgt, sqlNull: obj.Name()}, true
+			}
+		}
+
+		return field{}, false
+	}
+
+	p, ok := types.Unalias(t).(*types.Pointer)
+	if !ok {
+		return field{}, false
+	}
+
+	switch elem := types.Unalias(p.Elem()).(type) {
+	case *types.Basic:
+		if tgt, ok := basicPtrTargets[elem.Kind()]; ok {
+			return field{kind: kindPtr, target: tgt}, true
+		}
+	case *types.Named:
+		obj := elem.Obj()
+		if obj.Pkg() != nil {
+			if tgt, ok := namedPtrTargets[obj.Pkg().Path()+"."+obj.Name()]; ok {
+				return field{kind: kindPtr, target: tgt}, true
+			}
+		}
+	}
+
+	return field{}, false
+}
+
+// rewriteUses rewrites the usages of the migrated fields in file.
+func (m *migrator) rewriteUses(pkg *packages.Package, file *ast.File) {
+	lookup := func(obj types.Object) (field, bool) {
+		v, ok := obj.(*types.Var)
+		if !ok || !v.IsField() {
+			return field{}, false
+		}
+
+		fld, ok := m.declared[m.fset.Position(v.Pos())]
+
+		return fld, ok
+	}
+
+	var stack []ast.Node
+	ast.Inspect(file, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+
+		switch n := n.(type) {
+
+		case *ast.SelectorExpr:
+			selection, ok := pkg.TypesInfo.Selections[n]
+			if !ok || selection.Kind() != types.FieldVal {
+				return true
+			}
+			if fld, ok := lookup(selection.Obj()); ok {
+				m.rewriteSelector(pkg, stack, n, fld)
+			}
+
+		case *ast.KeyValueExpr:
+			key, ok := n.Key.(*ast.Ident)
+			if !ok {
+				return true
+			}
+			if fld, ok := lookup(pkg.TypesInfo.Uses[key]); ok {
+				m.rewriteValue(pkg, n.Value, fld)
+			}
+
+		case *ast.CompositeLit:
+			if len(n.Elts) == 0 {
+				return true
+			}
+			if _, ok := n.Elts[0].(*ast.KeyValueExpr); ok {
+				return true
+			}
+			st, ok := pkg.TypesInfo.TypeOf(n).Underlying().(*types.Struct)
+			if !ok {
+				return true
+			}
+			for i := range min(st.NumFields(), len(n.Elts)) {
+				if _, ok := lookup(st.Field(i)); ok {
+					m.report(n.Elts[i].Pos(), "positional composite literal field %s cannot be migrated; use keyed fields", st.Field(i).Name())
+				}
+			}
+		}
+
+		return true
+	})
+}
+
+// rewriteSelector rewrites x.F, the innermost node of stack, according to its context.
+func (m *migrator) rewriteSelector(pkg *packages.Package, stack []ast.Node, sel *ast.SelectorExpr, fld field) {
+	var expr ast.Expr = sel
+	i := len(stack) - 2
+	for ; i >= 0; i-- {
+		p, ok := stack[i].(*ast.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = p
+	}
+	parent := stack[i]
+
+	// Copies between fields migrated to the same type need no rewrite.
+	switch p := parent.(type) {
+	case *ast.KeyValueExpr:
+		if key, ok := p.Key.(*ast.Ident); ok && p.Value == expr {
+			if v, ok := pkg.TypesInfo.Uses[key].(*types.Var); ok && m.isMigrated(v, fld.target) {
+				return
+			}
+		}
+	case *ast.AssignStmt:
+		if j := slices.Index(p.Rhs, expr); j >= 0 && len(p.Lhs) == len(p.Rhs) && m.isFieldOf(pkg, p.Lhs[j], fld.target) {
+			return
+		}
+	}
+
+	// Assignments to the field.
+	if as, ok := parent.(*ast.AssignStmt); ok {
+		for j, lhs := range as.Lhs {
+			if lhs != expr {
+				continue
+			}
+			if as.Tok != token.ASSIGN || len(as.Lhs) != len(as.Rhs) {
+				m.report(sel.Pos(), "assignment to %s cannot be migrated", m.src(sel))
+				return
+			}
+
+			m.rewriteValue(pkg, as.Rhs[j], fld)
+
+			return
+		}
+	}
+
+	// &x.F keeps working with sql.Scanner-based APIs such as Rows.Scan.
+	if u, ok := parent.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		if !isScanArg(stack[:i]) {
+			m.report(sel.Pos(), "address of %s now has type *nullable.%s", m.src(sel), fld.target.name)
+		}
+		return
+	}
+
+	switch fld.kind {
+
+	case kindSQLNull:
+		// x.F.Int64 and x.F.Valid are promoted from the embedded database/sql.Null* type.
+		if p, ok := parent.(*ast.SelectorExpr); ok && p.X == expr {
+			return
+		}
+
+		m.addEdit(sel.End(), sel.End(), "."+fld.sqlNull)
+
+	case kindPtr:
+		switch p := parent.(type) {
+
+		case *ast.BinaryExpr:
+			other := p.Y
+			if p.Y == expr {
+				other = p.X
+			}
+			if !isNil(pkg.TypesInfo, other) || (p.Op != token.EQL && p.Op != token.NEQ) {
+				break
+			}
+
+			if p.Op == token.EQL {
+				m.addEdit(p.Pos(), p.End(), "!"+m.src(sel)+".Valid")
+			} else {
+				m.addEdit(p.Pos(), p.End(), m.src(sel)+".Valid")
+			}
+			return
+
+		case *ast.StarExpr:
+			if fld.target == targetTimestamp {
+				m.report(p.Pos(), "dereference of %s cannot be migrated", m.src(sel))
+				return
+			}
+
+			m.addEdit(p.Pos(), p.End(), m.src(sel)+"."+fld.target.payload)
+			return
+
+		case *ast.SelectorExpr:
+			if fld.target == targetTimestamp {
+				m.report(p.Pos(), "%s cannot be migrated", m.src(p))
+				return
+			}
+
+			// Methods and fields of the element are now reached through the payload.
+			m.addEdit(sel.End(), sel.End(), "."+fld.target.payload)
+			return
+		}
+
+		if fld.target.ptrAccessor == "" {
+			m.report(sel.Pos(), "use of %s as a pointer cannot be migrated", m.src(sel))
+			return
+		}
+
+		m.addEdit(sel.Pos(), sel.End(), m.src(sel)+"."+fld.target.ptrAccessor+"()")
+	}
+}
+
+// rewriteValue rewrites value, which is assigned to a migrated field.
+func (m *migrator) rewriteValue(pkg *packages.Package, value ast.Expr, fld field) {
+	value = ast.Unparen(value)
+	if m.isFieldOf(pkg, value, fld.target) {
+		return
+	}
+	newType := "nullable." + fld.target.name
+
+	switch fld.kind {
+
+	case kindSQLNull:
+		lit, ok := value.(*ast.CompositeLit)
+		if !ok {
+			m.addEdit(value.Pos(), value.End(), newType+"{"+fld.sqlNull+": "+m.src(value)+"}")
+			return
+		}
+
+		if len(lit.Elts) == 0 {
+			m.addEdit(value.Pos(), value.End(), newType+"{}")
+			return
+		}
+
+		payload, valid := fld.target.zero, "false"
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				m.report(lit.Pos(), "positional %s literal cannot be migrated", fld.sqlNull)
+				return
+			}
+
+			switch kv.Key.(*ast.Ident).Name {
+			case "Valid":
+				valid = m.src(kv.Value)
+			default:
+				payload = m.src(kv.Value)
+			}
+		}
+
+		m.addEdit(value.Pos(), value.End(), fmt.Sprintf("nullable.New%s(%s, %s)", fld.target.name, payload, valid))
+
+	case kindPtr:
+		if isNil(pkg.TypesInfo, value) {
+			m.addEdit(value.Pos(), value.End(), newType+"{}")
+			return
+		}
+
+		if u, ok := value.(*ast.UnaryExpr); ok && u.Op == token.AND {
+			x := m.src(u.X)
+			if fld.target == targetTimestamp {
+				x = "timeutil.NewTimestampFromUnix(" + x + ".Unix())"
+			}
+
+			m.addEdit(value.Pos(), value.End(), fmt.Sprintf("nullable.New%s(%s, true)", fld.target.name, x))
+			return
+		}
+
+		if fld.target.ptrCtor == "" {
+			m.report(value.Pos(), "pointer value %s cannot be migrated to %s", m.src(value), newType)
+			return
+		}
+
+		m.addEdit(value.Pos(), value.End(), fmt.Sprintf("nullable.%s(%s)", fld.target.ptrCtor, m.src(value)))
+	}
+}
+
+// isFieldOf reports whether expr selects a field migrated to tgt.
+func (m *migrator) isFieldOf(pkg *packages.Package, expr ast.Expr, tgt target) bool {
+	sel, ok := ast.Unparen(expr).(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	selection, ok := pkg.TypesInfo.Selections[sel]
+	if !ok || selection.Kind() != types.FieldVal {
+		return false
+	}
+
+	v, ok := selection.Obj().(*types.Var)
+
+	return ok && m.isMigrated(v, tgt)
+}
+
+func (m *migrator) isMigrated(v *types.Var, tgt target) bool {
+	fld, ok := m.declared[m.fset.Position(v.Pos())]
+
+	return ok && fld.target == tgt
+}
+
+func (m *migrator) addEdit(pos, end token.Pos, text string) {
+	p := m.fset.Position(pos)
+	e := edit{p.Offset, m.fset.Position(end).Offset, text}
+
+	for _, prev := range m.edits[p.Filename] {
+		if prev == e {
+			// The same file is visited again through a test variant of its package.
+			return
+		}
+		if e.start < prev.end && prev.start < e.end || e.start == e.end && prev.start < e.start && e.start < prev.end {
+			m.report(pos, "overlapping rewrite; convert this site manually")
+			return
+		}
+	}
+
+	m.edits[p.Filename] = append(m.edits[p.Filename], e)
+}
+
+func (m *migrator) report(pos token.Pos, format string, args ...any) {
+	p := m.fset.Position(pos)
+	if m.seen[p] {
+		return
+	}
+	m.seen[p] = true
+
+	m.issues = append(m.issues, Issue{Pos: p, Message: fmt.Sprintf(format, args...)})
+}
+
+// src returns the source text of node.
+func (m *migrator) src(node ast.Node) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, m.fset, node); err != nil {
+		return types.ExprString(node.(ast.Expr))
+	}
+
+	return buf.String()
+}
+
+// apply applies the edits to the file at path and fixes up its imports.
+func (m *migrator) apply(path string, edits []edit) (FileChange, error) {
+	old, err := os.ReadFile(path)
+	if err != nil {
+		return FileChange{}, err
+	}
+
+	slices.SortFunc(edits, func(a, b edit) int {
+		return cmp.Or(cmp.Compare(a.start, b.start), cmp.Compare(a.end, b.end))
+	})
+
+	var buf bytes.Buffer
+	last := 0
+	for _, e := range edits {
+		buf.Write(old[last:e.start])
+		buf.WriteString(e.text)
+		last = e.end
+	}
+	buf.Write(old[last:])
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, buf.Bytes(), parser.ParseComments)
+	if err != nil {
+		return FileChange{}, fmt.Errorf("failed to parse migrated %s: %w", path, err)
+	}
+
+	if usesIdent(file, "nullable") {
+		astutil.AddImport(fset, file, typeutil.NullablePkgPath)
+	}
+	if usesIdent(file, "timeutil") {
+		astutil.AddImport(fset, file, timeutilPkgPath)
+	}
+	for _, p := range []string{"database/sql", "time"} {
+		if !astutil.UsesImport(file, p) {
+			astutil.DeleteImport(fset, file, p)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := format.Node(&out, fset, file); err != nil {
+		return FileChange{}, fmt.Errorf("failed to format migrated %s: %w", path, err)
+	}
+
+	return FileChange{Path: path, Old: old, New: out.Bytes()}, nil
+}
+
+// usesIdent reports whether file has a selector expression on an identifier with the given name.
+func usesIdent(file *ast.File, name string) bool {
+	used := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Name == name {
+				used = true
+			}
+		}
+		return !used
+	})
+
+	return used
+}
+
+func isNil(info *types.Info, expr ast.Expr) bool {
+	tv, ok := info.Types[ast.Unparen(expr)]
+
+	return ok && tv.IsNil()
+}
+
+// isScanArg reports whether the innermost node of stack is a call to a method named Scan.
+func isScanArg(stack []ast.Node) bool {
+	if len(stack) == 0 {
+		return false
+	}
+
+	call, ok := stack[len(stack)-1].(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+
+	return ok && strings.HasPrefix(sel.Sel.Name, "Scan")
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := Migrate([]string{"./testdata/missing"})
+		require.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		res, err := Migrate([]string{"./testdata/models"})
+		require.NoError(t, err)
+		require.Len(t, res.Files, 1)
+
+		want, err := os.ReadFile(filepath.Join("testdata", "models.go.golden"))
+		require.NoError(t, err)
+		require.Equal(t, string(want), string(res.Files[0].New))
+
+		var issues []string
+		for _, issue := range res.Issues {
+			issues = append(issues, filepath.Base(issue.Pos.Filename)+":"+issue.Message)
+		}
+		require.Equal(t, []string{
+			"models.go:embedded database/sql.NullBool field cannot be migrated",
+			"models.go:dereference of u.DeletedAt cannot be migrated",
+		}, issues)
+	})
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type User struct {
+	ID        int64
+	Name      sql.NullString
+	Age       sql.NullInt64
+	Score     *float64
+	Nickname  *string
+	Wallet    *common.Address
+	DeletedAt *time.Time
+	Internal  sql.NullInt32
+	sql.NullBool
+}
+
+func NewUser(name string, age int64, wallet common.Address, now time.Time) User {
+	return User{
+		Name:      sql.NullString{String: name, Valid: true},
+		Age:       sql.NullInt64{Int64: age, Valid: age > 0},
+		Wallet:    &wallet,
+		DeletedAt: &now,
+		Internal:  sql.NullInt32{},
+	}
+}
+
+func (u *User) Describe() string {
+	if u.Nickname != nil {
+		return *u.Nickname
+	}
+	if u.Wallet == nil {
+		return u.Name.String
+	}
+
+	return u.Wallet.Hex()
+}
+
+func (u *User) Update(other User, score *float64, ni sql.NullInt64) {
+	u.Score = score
+	u.Nickname = nil
+	u.Age = ni
+	u.Name = other.Name
+	u.Wallet = other.Wallet
+	u.DeletedAt = nil
+	takeAge(u.Age)
+	takeScore(u.Score)
+	_ = *u.DeletedAt
+}
+
+func (u *User) Scan(rows *sql.Rows) error {
+	return rows.Scan(&u.ID, &u.Name, &u.Score)
+}
+
+func takeAge(sql.NullInt64) {}
+
+func takeScore(*float64) {}
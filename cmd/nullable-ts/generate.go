@@ -0,0 +1,488 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"go/types"
+	"reflect"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/m0t0k1ch1-go/nullable/v3/internal/typeutil"
+)
+
+// nullableTSTypes maps each nullable type to the TypeScript type of its non-null JSON form.
+var nullableTSTypes = map[string]string{
+	"Addr":                    "string",
+	"BigInt":                  "string",
+	"BigRat":                  "string",
+	"Bool":                    "boolean",
+	"Bytes":                   "string",
+	"BytesHex":                "string",
+	"BytesHexMax":             "string",
+	"BytesMax":                "string",
+	"Date":                    "string",
+	"Decimal":                 "string",
+	"DecimalNumber":           "number",
+	"Duration":                "string",
+	"DurationISO8601":         "string",
+	"DurationNanoseconds":     "number",
+	"DurationSeconds":         "number",
+	"EthAddress":              "string",
+	"EthHash":                 "string",
+	"Float64":                 "number",
+	"HTTPURL":                 "string",
+	"Int8":                    "number",
+	"Int16":                   "number",
+	"Int32":                   "number",
+	"Int64":                   "number",
+	"Int256":                  "string",
+	"Int256Numeric":           "string",
+	"JSON":                    "unknown",
+	"Number":                  "number",
+	"Prefix":                  "string",
+	"String":                  "string",
+	"Time":                    "string",
+	"TimeOfDay":               "string",
+	"Timestamp":               "number",
+	"TimestampLenient":        "number",
+	"TimestampMillis":         "number",
+	"TimestampMillisLenient":  "number",
+	"TimestampRFC3339":        "string",
+	"TimestampRFC3339Lenient": "string",
+	"Uint8":                   "number",
+	"Uint16":                  "number",
+	"Uint32":                  "number",
+	"Uint256":                 "string",
+	"Uint64":                  "number",
+	"URL":                     "string",
+	"URLOf":                   "string",
+	"UUID":                    "string",
+	"UUIDBinary":              "string",
+}
+
+// nullableGenericTSTypes maps each generic nullable type to a function returning the TypeScript type
+// of its non-null JSON form from the TypeScript type of the JSON form of its type argument.
+var nullableGenericTSTypes = map[string]func(arg string) string{
+	"JSONOf": func(v string) string {
+		return v
+	},
+	"Range": func(bound string) string {
+		return `{ lower: ` + bound + `; upper: ` + bound + `; bounds: "[)" | "[]" | "(]" | "()" } | { empty: true }`
+	},
+}
+
+// Generate loads the packages matching the patterns and returns the TypeScript source.
+func Generate(patterns []string) ([]byte, error) {
+	pkgs, err := typeutil.LoadPackages(packages.NeedName|packages.NeedTypes, patterns...)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		names: map[string]*types.TypeName{},
+	}
+
+	for _, pkg := range pkgs {
+		for _, obj := range typeutil.StructsWithNullableFields(pkg.Types) {
+			if err := g.enqueue(obj); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for len(g.queue) > 0 {
+		obj := g.queue[0]
+		g.queue = g.queue[1:]
+
+		if err := g.emit(obj); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by nullable-ts. DO NOT EDIT.\n")
+	for _, decl := range g.decls {
+		buf.WriteString("\n")
+		buf.WriteString(decl)
+	}
+
+	return buf.Bytes(), nil
+}
+
+type generator struct {
+	names map[string]*types.TypeName
+	queue []*types.TypeName
+	decls []string
+}
+
+// enqueue schedules the interface for obj, unless it has already been scheduled.
+func (g *generator) enqueue(obj *types.TypeName) error {
+	if prev, ok := g.names[obj.Name()]; ok {
+		if prev == obj {
+			return nil
+		}
+
+		return fmt.Errorf("duplicate type name %s: %s and %s", obj.Name(), prev.Pkg().Path(), obj.Pkg().Path())
+	}
+
+	g.names[obj.Name()] = obj
+	g.queue = append(g.queue, obj)
+
+	return nil
+}
+
+func (g *generator) emit(obj *types.TypeName) error {
+	st := obj.Type().Underlying().(*types.Struct)
+
+	body, err := g.structBody(st, "")
+	if err != nil {
+		return fmt.Errorf("%s.%s: %w", obj.Pkg().Path(), obj.Name(), err)
+	}
+
+	g.decls = append(g.decls, fmt.Sprintf("export interface %s %s\n", obj.Name(), body))
+
+	return nil
+}
+
+func (g *generator) structBody(st *types.Struct, indent string) (string, error) {
+	fields, err := jsonFields(st)
+	if err != nil {
+		return "", err
+	}
+
+	if len(fields) == 0 {
+		return "{}", nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	for _, f := range fields {
+		ts, err := g.tsType(f.typ, f.opts, indent+"  ")
+		if err != nil {
+			return "", fmt.Errorf("field %s: %w", f.goName, err)
+		}
+
+		sb.WriteString(indent + "  " + tsPropertyName(f.name))
+		if f.optional() {
+			sb.WriteString("?")
+		}
+		sb.WriteString(": " + ts + ";\n")
+	}
+	sb.WriteString(indent + "}")
+
+	return sb.String(), nil
+}
+
+// tsType returns the TypeScript type of the JSON encoding of t.
+func (g *generator) tsType(t types.Type, opts tagOptions, indent string) (string, error) {
+	if name, ok := typeutil.NullableName(t); ok {
+		ts, err := g.nullableTSType(t, name, indent)
+		if err != nil {
+			return "", err
+		}
+
+		return orNull(ts), nil
+	}
+
+	if typeutil.IsNamed(t, "time", "Time") {
+		return "string", nil
+	}
+
+	if p, ok := t.(*types.Pointer); ok {
+		ts, err := g.tsType(p.Elem(), opts, indent)
+		if err != nil {
+			return "", err
+		}
+		return orNull(ts), nil
+	}
+
+	switch {
+	case implements(t, "MarshalJSON"):
+		return "unknown", nil
+	case implements(t, "MarshalText"):
+		return "string", nil
+	}
+
+	switch u := t.Underlying().(type) {
+
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			if opts.has("string") {
+				return "string", nil
+			}
+			return "boolean", nil
+		case u.Info()&types.IsNumeric != 0:
+			if opts.has("string") {
+				return "string", nil
+			}
+			return "number", nil
+		case u.Info()&types.IsString != 0:
+			return "string", nil
+		}
+
+	case *types.Slice:
+		if isByte(u.Elem()) {
+			return "string | null", nil
+		}
+		ts, err := g.tsType(u.Elem(), "", indent)
+		if err != nil {
+			return "", err
+		}
+		return arrayOf(ts) + " | null", nil
+
+	case *types.Array:
+		if isByte(u.Elem()) {
+			return "number[]", nil
+		}
+		ts, err := g.tsType(u.Elem(), "", indent)
+		if err != nil {
+			return "", err
+		}
+		return arrayOf(ts), nil
+
+	case *types.Map:
+		ts, err := g.tsType(u.Elem(), "", indent)
+		if err != nil {
+			return "", err
+		}
+		return "Record<string, " + ts + "> | null", nil
+
+	case *types.Interface:
+		return "unknown", nil
+
+	case *types.Struct:
+		if named, ok := t.(*types.Named); ok && named.TypeParams().Len() == 0 && named.Obj().Exported() {
+			if err := g.enqueue(named.Obj()); err != nil {
+				return "", err
+			}
+			return named.Obj().Name(), nil
+		}
+		return g.structBody(u, indent)
+	}
+
+	return "", fmt.Errorf("unsupported type: %s", t)
+}
+
+// nullableTSType returns the TypeScript type of the non-null JSON form of the nullable type t named name.
+func (g *generator) nullableTSType(t types.Type, name string, indent string) (string, error) {
+	if f, ok := nullableGenericTSTypes[name]; ok {
+		arg, err := g.tsType(types.Unalias(t).(*types.Named).TypeArgs().At(0), "", indent)
+		if err != nil {
+			return "", err
+		}
+
+		return f(arg), nil
+	}
+
+	ts, ok := nullableTSTypes[name]
+	if !ok {
+		return "", fmt.Errorf("unsupported nullable type: %s", name)
+	}
+
+	return ts, nil
+}
+
+type jsonField struct {
+	name   string
+	goName string
+	typ    types.Type
+	opts   tagOptions
+	depth  int
+	tagged bool
+}
+
+// optional reports whether encoding/json may omit the field.
+func (f jsonField) optional() bool {
+	if f.opts.has("omitzero") {
+		return true
+	}
+	if !f.opts.has("omitempty") {
+		return false
+	}
+
+	switch u := f.typ.Underlying().(type) {
+	case *types.Basic, *types.Pointer, *types.Slice, *types.Map, *types.Interface:
+		return true
+	case *types.Array:
+		return u.Len() == 0
+	}
+
+	return false
+}
+
+// jsonFields returns the fields of st as encoding/json sees them, with embedded structs inlined.
+func jsonFields(st *types.Struct) ([]jsonField, error) {
+	var all []jsonField
+	if err := collectFields(st, 0, map[*types.Struct]bool{}, &all); err != nil {
+		return nil, err
+	}
+
+	// Apply the Go visibility rules: the shallowest field wins, then the tagged one;
+	// ambiguous fields are dropped.
+	byName := map[string][]jsonField{}
+	for _, f := range all {
+		byName[f.name] = append(byName[f.name], f)
+	}
+
+	var fields []jsonField
+	for _, f := range all {
+		cands := byName[f.name]
+		if cands == nil {
+			continue
+		}
+		delete(byName, f.name)
+
+		if dominant, ok := dominantField(cands); ok {
+			fields = append(fields, dominant)
+		}
+	}
+
+	return fields, nil
+}
+
+func collectFields(st *types.Struct, depth int, visited map[*types.Struct]bool, out *[]jsonField) error {
+	if visited[st] {
+		return nil
+	}
+	visited[st] = true
+	defer delete(visited, st)
+
+	for i := range st.NumFields() {
+		v := st.Field(i)
+
+		tag := reflect.StructTag(st.Tag(i)).Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if v.Anonymous() {
+			t := v.Type()
+			if p, ok := t.(*types.Pointer); ok {
+				t = p.Elem()
+			}
+
+			if name == "" && !typeutil.IsNullable(t) {
+				if est, ok := t.Underlying().(*types.Struct); ok {
+					if err := collectFields(est, depth+1, visited, out); err != nil {
+						return err
+					}
+					continue
+				}
+			}
+
+			if !v.Exported() {
+				continue
+			}
+		} else if !v.Exported() {
+			continue
+		}
+
+		f := jsonField{
+			name:   name,
+			goName: v.Name(),
+			typ:    v.Type(),
+			opts:   tagOptions(opts),
+			depth:  depth,
+			tagged: name != "",
+		}
+		if f.name == "" {
+			f.name = v.Name()
+		}
+
+		*out = append(*out, f)
+	}
+
+	return nil
+}
+
+func dominantField(fields []jsonField) (jsonField, bool) {
+	slices.SortStableFunc(fields, func(a, b jsonField) int {
+		return cmp.Compare(a.depth, b.depth)
+	})
+
+	var top []jsonField
+	for _, f := range fields {
+		if f.depth == fields[0].depth {
+			top = append(top, f)
+		}
+	}
+
+	var tagged []jsonField
+	for _, f := range top {
+		if f.tagged {
+			tagged = append(tagged, f)
+		}
+	}
+
+	switch {
+	case len(top) == 1:
+		return top[0], true
+	case len(tagged) == 1:
+		return tagged[0], true
+	}
+
+	return jsonField{}, false
+}
+
+type tagOptions string
+
+func (o tagOptions) has(opt string) bool {
+	for s := range strings.SplitSeq(string(o), ",") {
+		if s == opt {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isByte(t types.Type) bool {
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Kind() == types.Byte
+}
+
+// implements reports whether t or *t has a method with the given name.
+func implements(t types.Type, method string) bool {
+	for _, typ := range []types.Type{t, types.NewPointer(t)} {
+		obj, _, _ := types.LookupFieldOrMethod(typ, true, nil, method)
+		if _, ok := obj.(*types.Func); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func orNull(ts string) string {
+	if strings.HasSuffix(ts, " | null") {
+		return ts
+	}
+
+	return ts + " | null"
+}
+
+func arrayOf(ts string) string {
+	if strings.Contains(ts, " ") {
+		return "(" + ts + ")[]"
+	}
+
+	return ts + "[]"
+}
+
+func tsPropertyName(name string) string {
+	for i, r := range name {
+		if r == '_' || r == '$' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || (i > 0 && '0' <= r && r <= '9') {
+			continue
+		}
+
+		return fmt.Sprintf("%q", name)
+	}
+
+	return name
+}
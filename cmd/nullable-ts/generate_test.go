@@ -0,0 +1,68 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		b, err := Generate([]string{"./testdata/dto"})
+		require.NoError(t, err)
+		require.Equal(t, `// Code generated by nullable-ts. DO NOT EDIT.
+
+export interface User {
+  id: number;
+  name: string | null;
+  age: number | null;
+  verified?: boolean | null;
+  balance: string | null;
+  wallet: string | null;
+  website: string | null;
+  profile: Profile;
+  tags: string[] | null;
+  created_at: string;
+  updated_at: number | null;
+  tx_hash: string | null;
+  deleted_at: string | null;
+}
+
+export interface Audit {
+  updated_at: number | null;
+  tx_hash: string | null;
+  deleted_at: string | null;
+}
+
+export interface Profile {
+  bio?: string;
+  score: number | null;
+  counts: Record<string, number> | null;
+  nonce: string;
+}
+
+export interface Block {
+  number: number | null;
+  Labels: (string | null)[] | null;
+}
+
+export interface Booking {
+  period: { lower: string | null; upper: string | null; bounds: "[)" | "[]" | "(]" | "()" } | { empty: true } | null;
+  seats: { lower: number | null; upper: number | null; bounds: "[)" | "[]" | "(]" | "()" } | { empty: true } | null;
+  guest: Guest | null;
+  extras: Record<string, number> | null;
+  options: (string | null)[] | null;
+  raw: unknown | null;
+}
+
+export interface Guest {
+  name: string;
+}
+`, string(b))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := Generate([]string{"./testdata/missing"})
+		require.Error(t, err)
+	})
+}
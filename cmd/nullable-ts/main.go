@@ -0,0 +1,49 @@
+// Command nullable-ts generates TypeScript interfaces for Go structs with nullable fields.
+//
+// Usage:
+//
+//	nullable-ts [-o file] [packages]
+//
+// It loads the given packages, finds exported structs that have at least one
+// field of a nullable type, and emits an interface for each of them (and for
+// any struct they reference), following the way encoding/json serializes them.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	out := flag.String("o", "", "output file (default: stdout)")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: nullable-ts [-o file] [packages]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+
+	if err := run(patterns, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "nullable-ts:", err)
+		os.Exit(1)
+	}
+}
+
+func run(patterns []string, out string) error {
+	b, err := Generate(patterns)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err := os.Stdout.Write(b)
+		return err
+	}
+
+	return os.WriteFile(out, b, 0o644)
+}
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+)
+
+type User struct {
+	ID        int64                `json:"id"`
+	Name      nullable.String      `json:"name"`
+	Age       nullable.Int32       `json:"age,omitempty"`
+	Verified  nullable.Bool        `json:"verified,omitzero"`
+	Balance   nullable.Uint256     `json:"balance"`
+	Wallet    *nullable.EthAddress `json:"wallet"`
+	Website   nullable.HTTPURL     `json:"website"`
+	Profile   Profile              `json:"profile"`
+	Tags      []string             `json:"tags"`
+	CreatedAt time.Time            `json:"created_at"`
+	Secret    string               `json:"-"`
+	internal  string
+	Audit
+}
+
+type Audit struct {
+	UpdatedAt nullable.Timestamp `json:"updated_at"`
+	TxHash    nullable.EthHash   `json:"tx_hash,omitempty"`
+	DeletedAt nullable.Time      `json:"deleted_at"`
+}
+
+type Profile struct {
+	Bio    string            `json:"bio,omitempty"`
+	Score  nullable.Float64  `json:"score"`
+	Counts map[string]uint64 `json:"counts"`
+	Nonce  uint64            `json:"nonce,string"`
+}
+
+type Plain struct {
+	Name string `json:"name"`
+}
+
+type Block struct {
+	Number nullable.Uint64 `json:"number"`
+	Labels []nullable.String
+}
+
+type Booking struct {
+	Period  nullable.Range[nullable.Time]      `json:"period"`
+	Seats   nullable.Range[nullable.Int32]     `json:"seats"`
+	Guest   nullable.JSONOf[Guest]             `json:"guest"`
+	Extras  nullable.JSONOf[map[string]int]    `json:"extras"`
+	Options nullable.JSONOf[[]nullable.String] `json:"options"`
+	Raw     nullable.JSONOf[any]               `json:"raw"`
+}
+
+type Guest struct {
+	Name string `json:"name"`
+}
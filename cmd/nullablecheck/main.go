@@ -0,0 +1,20 @@
+// Command nullablecheck runs the nullablecheck analyzer.
+//
+// It can be run standalone:
+//
+//	nullablecheck [-fix] [-test=false] [packages]
+//
+// or through go vet:
+//
+//	go vet -vettool=$(which nullablecheck) [packages]
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/m0t0k1ch1-go/nullable/v3/nullablecheck"
+)
+
+func main() {
+	singlechecker.Main(nullablecheck.Analyzer)
+}
@@ -0,0 +1,490 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"go/types"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/m0t0k1ch1-go/nullable/v3/internal/typeutil"
+)
+
+// Config holds the parameters of a generated wrapper.
+type Config struct {
+	// TypePath is the fully qualified type to wrap, e.g. "github.com/google/uuid.UUID".
+	TypePath string
+
+	// Name is the name of the wrapper type. It defaults to the wrapped type name.
+	Name string
+
+	// Package is the package name of the generated files.
+	Package string
+
+	// PkgPath is the import path of the generated package, used by the test file.
+	// If empty, the test file is generated as an internal test.
+	PkgPath string
+}
+
+// File represents a generated file.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Generate returns the wrapper file and its test file for cfg.
+func Generate(cfg Config) ([]File, error) {
+	idx := strings.LastIndex(cfg.TypePath, ".")
+	if idx <= 0 || idx == len(cfg.TypePath)-1 {
+		return nil, fmt.Errorf("invalid type path: %q", cfg.TypePath)
+	}
+	importPath, typeName := cfg.TypePath[:idx], cfg.TypePath[idx+1:]
+
+	if cfg.Name == "" {
+		cfg.Name = typeName
+	}
+	if !token.IsIdentifier(cfg.Name) || !token.IsExported(cfg.Name) {
+		return nil, fmt.Errorf("invalid name: %q", cfg.Name)
+	}
+	if cfg.Package == "" {
+		cfg.Package = "nullable"
+	}
+
+	pkgs, err := typeutil.LoadPackages(packages.NeedName|packages.NeedTypes, importPath)
+	if err != nil {
+		return nil, err
+	}
+	pkg := pkgs[0]
+
+	obj, ok := pkg.Types.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok || !obj.Exported() {
+		return nil, fmt.Errorf("type not found: %s", cfg.TypePath)
+	}
+
+	if !hasMethod(types.NewPointer(obj.Type()), "Scan") {
+		return nil, fmt.Errorf("%s does not implement sql.Scanner", cfg.TypePath)
+	}
+	if !hasMethod(types.NewPointer(obj.Type()), "Value") {
+		return nil, fmt.Errorf("%s does not implement driver.Valuer", cfg.TypePath)
+	}
+
+	data := newTemplateData(cfg, pkg.Types, obj)
+
+	base := strings.ToLower(cfg.Name)
+
+	src, err := execute(wrapperTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+
+	testSrc, err := execute(testTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return []File{
+		{Name: base + ".go", Content: src},
+		{Name: base + "_test.go", Content: testSrc},
+	}, nil
+}
+
+type templateData struct {
+	Package      string
+	Name         string
+	Param        string
+	ImportAlias  string
+	NeedsAlias   bool
+	ImportPath   string
+	InnerType    string
+	Zero         string
+	HasString    bool
+	ExternalTest bool
+	PkgPath      string
+	Qualifier    string
+	StringPrefix string
+
+	TestStringPrefix    string
+	TestImportsNullable bool
+}
+
+func newTemplateData(cfg Config, pkg *types.Package, obj *types.TypeName) templateData {
+	alias := pkg.Name()
+	if alias == cfg.Package || alias == "nullable" || alias == "require" {
+		alias = "inner" + alias
+	}
+
+	param, _ := utf8.DecodeRuneInString(cfg.Name)
+	paramName := string(unicode.ToLower(param))
+	if paramName == "n" || paramName == alias {
+		paramName = "v"
+	}
+
+	data := templateData{
+		Package:     cfg.Package,
+		Name:        cfg.Name,
+		Param:       paramName,
+		ImportAlias: alias,
+		NeedsAlias:  alias != pkg.Name(),
+		ImportPath:  pkg.Path(),
+		InnerType:   alias + "." + obj.Name(),
+		Zero:        zeroValue(alias+"."+obj.Name(), obj.Type()),
+		HasString:   hasMethod(obj.Type(), "String"),
+		PkgPath:     cfg.PkgPath,
+	}
+
+	if cfg.Package != "nullable" {
+		data.StringPrefix = "nullable."
+	}
+
+	if cfg.PkgPath != "" {
+		data.ExternalTest = true
+		data.Qualifier = cfg.Package + "."
+	}
+
+	if data.ExternalTest || cfg.Package != "nullable" {
+		data.TestStringPrefix = "nullable."
+		data.TestImportsNullable = data.HasString && cfg.PkgPath != typeutil.NullablePkgPath
+	}
+
+	return data
+}
+
+func execute(tmpl *template.Template, data templateData) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to format generated code"), err)
+	}
+
+	return src, nil
+}
+
+// zeroValue returns an expression for the zero value of t, spelled typ.
+// Composite literals only work for struct and array types, so other types use *new(typ).
+func zeroValue(typ string, t types.Type) string {
+	switch t.Underlying().(type) {
+	case *types.Struct, *types.Array:
+		return typ + "{}"
+	default:
+		return "*new(" + typ + ")"
+	}
+}
+
+// hasMethod reports whether the method set of t includes the named method.
+func hasMethod(t types.Type, name string) bool {
+	ms := types.NewMethodSet(t)
+	for i := range ms.Len() {
+		if ms.At(i).Obj().Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+var wrapperTemplate = template.Must(template.New("wrapper").Parse(`// Code generated by nullablegen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+
+	{{if .NeedsAlias}}{{.ImportAlias}} {{end}}"{{.ImportPath}}"
+{{- if and .HasString .StringPrefix}}
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+{{- end}}
+)
+
+// {{.Name}} represents a nullable {{.InnerType}}.
+type {{.Name}} struct {
+	{{.Name}} {{.InnerType}}
+	Valid bool
+}
+
+// New{{.Name}} returns a new {{.Name}}.
+func New{{.Name}}({{.Param}} {{.InnerType}}, valid bool) {{.Name}} {
+	return {{.Name}}{
+		{{.Name}}: {{.Param}},
+		Valid: valid,
+	}
+}
+{{- if .HasString}}
+
+// NullableString returns the value as a String.
+func (n {{.Name}}) NullableString() {{.StringPrefix}}String {
+	if !n.Valid {
+		return {{.StringPrefix}}NewString("", false)
+	}
+
+	return {{.StringPrefix}}NewString(n.{{.Name}}.String(), true)
+}
+{{- end}}
+
+// Value implements driver.Valuer.
+// It returns the driver.Value returned by {{.InnerType}}.Value, or nil if invalid.
+func (n {{.Name}}) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.{{.Name}}.Value()
+}
+
+// Scan implements sql.Scanner.
+// It accepts any value supported by {{.InnerType}}.Scan, or nil.
+func (n *{{.Name}}) Scan(src any) error {
+	if src == nil {
+		n.{{.Name}}, n.Valid = {{.Zero}}, false
+
+		return nil
+	}
+
+	if err := n.{{.Name}}.Scan(src); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the JSON encoding of {{.InnerType}}, or null if invalid.
+func (n {{.Name}}) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.{{.Name}})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts any JSON value supported by {{.InnerType}}, or null.
+func (n *{{.Name}}) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.{{.Name}}, n.Valid = {{.Zero}}, false
+
+		return nil
+	}
+
+	if err := json.Unmarshal(b, &n.{{.Name}}); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+`))
+
+var testTemplate = template.Must(template.New("test").Parse(`package {{.Package}}{{if .ExternalTest}}_test{{end}}
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	{{if .NeedsAlias}}{{.ImportAlias}} {{end}}"{{.ImportPath}}"
+	"github.com/stretchr/testify/require"
+{{- if or .ExternalTest .TestImportsNullable}}
+{{if .ExternalTest}}
+	"{{.PkgPath}}"
+{{- end}}
+{{- if .TestImportsNullable}}
+	"github.com/m0t0k1ch1-go/nullable/v3"
+{{- end}}
+{{- end}}
+)
+
+func Test{{.Name}}(t *testing.T) {
+	var n {{.Qualifier}}{{.Name}}
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+{{- if .HasString}}
+
+func Test{{.Name}}_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   {{.Qualifier}}{{.Name}}
+			want {{.TestStringPrefix}}String
+		}{
+			{
+				"null",
+				{{.Qualifier}}New{{.Name}}({{.Zero}}, false),
+				{{.TestStringPrefix}}NewString("", false),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				s := tc.in.NullableString()
+				require.Equal(t, tc.want, s)
+			})
+		}
+	})
+}
+{{- end}}
+
+func Test{{.Name}}_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   {{.Qualifier}}{{.Name}}
+			want driver.Value
+		}{
+			{
+				"null",
+				{{.Qualifier}}New{{.Name}}({{.Zero}}, false),
+				nil,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func Test{{.Name}}_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"struct",
+				struct{}{},
+				"",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n {{.Qualifier}}{{.Name}}
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want {{.Qualifier}}{{.Name}}
+		}{
+			{
+				"nil",
+				nil,
+				{{.Qualifier}}New{{.Name}}({{.Zero}}, false),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n {{.Qualifier}}{{.Name}}
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.Valid, n.Valid)
+				require.Equal(t, tc.want.{{.Name}}, n.{{.Name}})
+			})
+		}
+	})
+}
+
+func Test{{.Name}}_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   {{.Qualifier}}{{.Name}}
+			want []byte
+		}{
+			{
+				"null",
+				{{.Qualifier}}New{{.Name}}({{.Zero}}, false),
+				[]byte(` + "`null`" + `),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := tc.in.MarshalJSON()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func Test{{.Name}}_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"empty",
+				[]byte{},
+				"",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n {{.Qualifier}}{{.Name}}
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want {{.Qualifier}}{{.Name}}
+		}{
+			{
+				"null",
+				[]byte(` + "`null`" + `),
+				{{.Qualifier}}New{{.Name}}({{.Zero}}, false),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n {{.Qualifier}}{{.Name}}
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.Valid, n.Valid)
+				require.Equal(t, tc.want.{{.Name}}, n.{{.Name}})
+			})
+		}
+	})
+}
+`))
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	testdataPkgPath    = "github.com/m0t0k1ch1-go/nullable/v3/cmd/nullablegen/testdata/code"
+	testdataGenPkgPath = "github.com/m0t0k1ch1-go/nullable/v3/cmd/nullablegen/testdata/gen"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   Config
+			want string
+		}{
+			{
+				"invalid type path",
+				Config{TypePath: "Code"},
+				"invalid type path",
+			},
+			{
+				"invalid name",
+				Config{TypePath: testdataPkgPath + ".Code", Name: "code"},
+				"invalid name",
+			},
+			{
+				"type not found",
+				Config{TypePath: testdataPkgPath + ".Missing"},
+				"type not found",
+			},
+			{
+				"not a sql.Scanner",
+				Config{TypePath: testdataPkgPath + ".Opaque"},
+				"does not implement sql.Scanner",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := Generate(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   Config
+		}{
+			{
+				"struct",
+				Config{
+					TypePath: testdataPkgPath + ".Code",
+					Package:  "nullable",
+					PkgPath:  "github.com/m0t0k1ch1-go/nullable/v3",
+				},
+			},
+			{
+				"scalar",
+				Config{
+					TypePath: testdataPkgPath + ".Level",
+					Package:  "gen",
+					PkgPath:  testdataGenPkgPath,
+				},
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				files, err := Generate(tc.in)
+				require.NoError(t, err)
+				require.Len(t, files, 2)
+
+				for _, f := range files {
+					want, err := os.ReadFile(filepath.Join("testdata", f.Name+".golden"))
+					require.NoError(t, err)
+					require.Equal(t, string(want), string(f.Content))
+				}
+			})
+		}
+	})
+
+	t.Run("compile", func(t *testing.T) {
+		files, err := Generate(Config{
+			TypePath: testdataPkgPath + ".Level",
+			Package:  "gen",
+			PkgPath:  testdataGenPkgPath,
+		})
+		require.NoError(t, err)
+
+		// The generated files exist only in the overlay, so the source tree is left untouched.
+		dir, err := filepath.Abs(filepath.Join("testdata", "gen"))
+		require.NoError(t, err)
+
+		overlay := make(map[string][]byte, len(files))
+		for _, f := range files {
+			overlay[filepath.Join(dir, f.Name)] = f.Content
+		}
+
+		pkgs, err := packages.Load(&packages.Config{
+			Mode:    packages.NeedName | packages.NeedTypes,
+			Tests:   true,
+			Overlay: overlay,
+		}, testdataGenPkgPath)
+		require.NoError(t, err)
+		require.NotEmpty(t, pkgs)
+
+		for _, pkg := range pkgs {
+			require.Empty(t, pkg.Errors, pkg.ID)
+		}
+	})
+}
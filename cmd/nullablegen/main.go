@@ -0,0 +1,129 @@
+// Command nullablegen generates a nullable wrapper for a type that implements
+// sql.Scanner and driver.Valuer, following the layout of the types in the nullable package.
+//
+// Usage:
+//
+//	nullablegen -type github.com/google/uuid.UUID [-name UUID] [-pkg nullable] [-o dir]
+//
+// It writes <name>.go with the wrapper (constructor, NullableString, Value, Scan,
+// MarshalJSON and UnmarshalJSON) and <name>_test.go with table-driven tests
+// to be extended with cases for valid values.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+)
+
+func main() {
+	typePath := flag.String("type", "", "fully qualified type to wrap, e.g. github.com/google/uuid.UUID")
+	name := flag.String("name", "", "name of the wrapper type (default: the wrapped type name)")
+	pkg := flag.String("pkg", "", "package name of the generated files (default: the name of the package in the output directory, or nullable)")
+	out := flag.String("o", ".", "output directory")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: nullablegen -type path.Type [-name Name] [-pkg name] [-o dir]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *typePath == "" || flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*typePath, *name, *pkg, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "nullablegen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(typePath, name, pkg, out string) error {
+	if pkg == "" {
+		pkg = detectPackageName(out)
+	}
+
+	pkgPath, err := detectPkgPath(out)
+	if err != nil {
+		return err
+	}
+
+	files, err := Generate(Config{
+		TypePath: typePath,
+		Name:     name,
+		Package:  pkg,
+		PkgPath:  pkgPath,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		if err := os.WriteFile(filepath.Join(out, f.Name), f.Content, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// detectPackageName returns the package name of the first non-test Go file in dir, or "nullable".
+func detectPackageName(dir string) string {
+	matches, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	for _, m := range matches {
+		if strings.HasSuffix(m, "_test.go") {
+			continue
+		}
+
+		b, err := os.ReadFile(m)
+		if err != nil {
+			continue
+		}
+
+		for line := range strings.Lines(string(b)) {
+			if name, ok := strings.CutPrefix(line, "package "); ok {
+				return strings.TrimSpace(name)
+			}
+		}
+	}
+
+	return "nullable"
+}
+
+// detectPkgPath returns the import path of dir derived from the enclosing go.mod.
+func detectPkgPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for d := abs; ; d = filepath.Dir(d) {
+		b, err := os.ReadFile(filepath.Join(d, "go.mod"))
+		if err == nil {
+			modPath := modfile.ModulePath(b)
+			if modPath == "" {
+				return "", fmt.Errorf("no module path in %s", filepath.Join(d, "go.mod"))
+			}
+
+			rel, err := filepath.Rel(d, abs)
+			if err != nil {
+				return "", err
+			}
+			if rel == "." {
+				return modPath, nil
+			}
+
+			return path.Join(modPath, filepath.ToSlash(rel)), nil
+		}
+
+		if filepath.Dir(d) == d {
+			return "", errors.New("go.mod not found")
+		}
+	}
+}
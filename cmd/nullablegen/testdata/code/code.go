@@ -0,0 +1,58 @@
+package code
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+type Code struct {
+	s string
+}
+
+func (c Code) String() string {
+	return c.s
+}
+
+func (c Code) Value() (driver.Value, error) {
+	return c.s, nil
+}
+
+func (c *Code) Scan(src any) error {
+	s, ok := src.(string)
+	if !ok {
+		return errors.New("unsupported source type")
+	}
+
+	c.s = strings.ToUpper(s)
+
+	return nil
+}
+
+type Opaque struct{}
+
+func (o Opaque) Value() (driver.Value, error) {
+	return nil, nil
+}
+
+type Level int
+
+func (l Level) String() string {
+	return strconv.Itoa(int(l))
+}
+
+func (l Level) Value() (driver.Value, error) {
+	return int64(l), nil
+}
+
+func (l *Level) Scan(src any) error {
+	v, ok := src.(int64)
+	if !ok {
+		return errors.New("unsupported source type")
+	}
+
+	*l = Level(v)
+
+	return nil
+}
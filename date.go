@@ -0,0 +1,257 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"time"
+)
+
+// dateLayout is the layout of dates in SQL and JSON.
+const dateLayout = "2006-01-02"
+
+// Date represents a nullable calendar date, without a time of day or time zone.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+	Valid bool
+}
+
+// NewDate returns a new Date.
+func NewDate(year int, month time.Month, day int, valid bool) Date {
+	return Date{
+		Year:  year,
+		Month: month,
+		Day:   day,
+		Valid: valid,
+	}
+}
+
+// NewDateFromTime returns a new Date from the date of t in its location.
+func NewDateFromTime(t time.Time, valid bool) Date {
+	year, month, day := t.Date()
+
+	return NewDate(year, month, day, valid)
+}
+
+// NullableString returns the value as a String in the form 2006-01-02.
+func (n Date) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(n.format(), true)
+}
+
+// In returns the start of the date in loc, or the zero time.Time if invalid.
+func (n Date) In(loc *time.Location) time.Time {
+	if !n.Valid {
+		return time.Time{}
+	}
+
+	return time.Date(n.Year, n.Month, n.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns the date n days after the value,
+// or null if invalid or if the result is outside of 0001-01-01 to 9999-12-31.
+// days may be negative.
+func (n Date) AddDays(days int) Date {
+	return n.AddDate(0, 0, days)
+}
+
+// AddDate returns the date the given numbers of years, months and days after the value,
+// normalized as time.Time.AddDate does,
+// or null if invalid or if the result is outside of 0001-01-01 to 9999-12-31.
+func (n Date) AddDate(years int, months int, days int) Date {
+	if !n.Valid {
+		return Date{}
+	}
+
+	d := NewDateFromTime(n.In(time.UTC).AddDate(years, months, days), true)
+	if d.check() != nil {
+		return Date{}
+	}
+
+	return d
+}
+
+// DaysSince returns the number of days from u to the value, or null if either is invalid.
+func (n Date) DaysSince(u Date) Int64 {
+	if !n.Valid || !u.Valid {
+		return NewInt64(0, false)
+	}
+
+	return NewInt64((n.In(time.UTC).Unix()-u.In(time.UTC).Unix())/(24*60*60), true)
+}
+
+// Compare compares the value with u, returning -1, 0 or +1.
+// Null sorts before all valid dates.
+func (n Date) Compare(u Date) int {
+	if !n.Valid || !u.Valid {
+		return compareValid(n.Valid, u.Valid)
+	}
+
+	return n.In(time.UTC).Compare(u.In(time.UTC))
+}
+
+// Before reports whether the value is before u, or returns null if either is invalid.
+func (n Date) Before(u Date) Bool {
+	if !n.Valid || !u.Valid {
+		return NewBool(false, false)
+	}
+
+	return NewBool(n.Compare(u) < 0, true)
+}
+
+// After reports whether the value is after u, or returns null if either is invalid.
+func (n Date) After(u Date) Bool {
+	if !n.Valid || !u.Valid {
+		return NewBool(false, false)
+	}
+
+	return NewBool(n.Compare(u) > 0, true)
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a string in the form 2006-01-02, or nil if invalid.
+// It returns an error if the year is outside of [1, 9999].
+func (n Date) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	if err := n.check(); err != nil {
+		return nil, err
+	}
+
+	return n.format(), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts:
+//   - time.Time (its date in its location)
+//   - string (2006-01-02, from 0001-01-01 to 9999-12-31)
+//   - []byte (2006-01-02, from 0001-01-01 to 9999-12-31)
+//   - nil
+func (n *Date) Scan(src any) error {
+	if src == nil {
+		*n = Date{}
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case time.Time:
+		*n = NewDateFromTime(v, true)
+
+		return nil
+
+	case string:
+		return n.parse(v)
+
+	case []byte:
+		return n.parse(string(v))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in the form 2006-01-02, or null if invalid.
+// It returns an error if the year is outside of [1, 9999].
+func (n Date) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	if err := n.check(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(n.format())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string in the form 2006-01-02, from 0001-01-01 to 9999-12-31, or null.
+func (n *Date) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = Date{}
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	return n.parse(s)
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (0001-01-01, 1970-01-01, 2000-02-29 or 9999-12-31),
+// or a random date between 0001-01-01 and 9999-12-31.
+func (Date) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(Date{})
+	}
+
+	if d, ok := generateEdge(r,
+		NewDate(1, time.January, 1, true),
+		NewDate(1970, time.January, 1, true),
+		NewDate(2000, time.February, 29, true),
+		NewDate(9999, time.December, 31, true),
+	); ok {
+		return reflect.ValueOf(d)
+	}
+
+	return reflect.ValueOf(NewDate(1, time.January, 1, true).AddDays(r.Intn(3652059)))
+}
+
+// check returns an error if the year, normalized as time.Date does, is outside of [1, 9999],
+// which the form 2006-01-02 cannot represent.
+func (n Date) check() error {
+	if year := n.In(time.UTC).Year(); year < 1 || year > 9999 {
+		return fmt.Errorf("invalid date: year %d is outside of [1, 9999]", year)
+	}
+
+	return nil
+}
+
+func (n Date) format() string {
+	return n.In(time.UTC).Format(dateLayout)
+}
+
+func (n *Date) parse(s string) error {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	d := NewDateFromTime(t, true)
+	if err := d.check(); err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	*n = d
+
+	return nil
+}
+
+// compareValid compares validity, with null before valid.
+func compareValid(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
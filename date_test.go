@@ -0,0 +1,615 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestDate(t *testing.T) {
+	var n nullable.Date
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestDate_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Date]{
+		Valid: []nullable.Date{
+			nullable.NewDate(1, time.January, 1, true),
+			nullable.NewDate(2009, time.January, 3, true),
+			nullable.NewDate(2000, time.February, 29, true),
+			nullable.NewDate(9999, time.December, 31, true),
+		},
+		InvalidScan: []any{
+			int64(0),
+			"2009-02-29",
+			[]byte("2009-01-03T00:00:00Z"),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`0`),
+			[]byte(`""`),
+			[]byte(`"2009-1-3"`),
+		},
+	})
+}
+
+func TestNewDateFromTime(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   time.Time
+			want nullable.Date
+		}{
+			{
+				"UTC",
+				genesis,
+				nullable.NewDate(2009, time.January, 3, true),
+			},
+			{
+				"JST: date in its location",
+				genesis.In(jst),
+				nullable.NewDate(2009, time.January, 4, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n := nullable.NewDateFromTime(tc.in, true)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDate_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Date
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewDate(0, 0, 0, false),
+				nullable.NewString("", false),
+			},
+			{
+				"genesis",
+				nullable.NewDate(2009, time.January, 3, true),
+				nullable.NewString("2009-01-03", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n := tc.in.NullableString()
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDate_In(t *testing.T) {
+	require.Equal(t, time.Time{}, nullable.NewDate(2009, time.January, 3, false).In(time.UTC))
+	require.Equal(t, time.Date(2009, 1, 3, 0, 0, 0, 0, jst), nullable.NewDate(2009, time.January, 3, true).In(jst))
+}
+
+func TestDate_AddDate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name                string
+			in                  nullable.Date
+			years, months, days int
+			want                nullable.Date
+		}{
+			{
+				"null",
+				nullable.NewDate(0, 0, 0, false),
+				0, 0, 1,
+				nullable.NewDate(0, 0, 0, false),
+			},
+			{
+				"null with a stale date",
+				nullable.NewDate(2009, time.January, 3, false),
+				0, 0, 1,
+				nullable.NewDate(0, 0, 0, false),
+			},
+			{
+				"next day",
+				nullable.NewDate(2009, time.January, 3, true),
+				0, 0, 1,
+				nullable.NewDate(2009, time.January, 4, true),
+			},
+			{
+				"across a leap day",
+				nullable.NewDate(2000, time.February, 28, true),
+				0, 0, 2,
+				nullable.NewDate(2000, time.March, 1, true),
+			},
+			{
+				"previous year",
+				nullable.NewDate(2009, time.January, 3, true),
+				0, 0, -3,
+				nullable.NewDate(2008, time.December, 31, true),
+			},
+			{
+				"month overflow is normalized",
+				nullable.NewDate(2009, time.January, 31, true),
+				0, 1, 0,
+				nullable.NewDate(2009, time.March, 3, true),
+			},
+			{
+				"leap year to common year",
+				nullable.NewDate(2000, time.February, 29, true),
+				1, 0, 0,
+				nullable.NewDate(2001, time.March, 1, true),
+			},
+			{
+				"after 9999-12-31",
+				nullable.NewDate(9999, time.December, 31, true),
+				0, 0, 1,
+				nullable.NewDate(0, 0, 0, false),
+			},
+			{
+				"before 0001-01-01",
+				nullable.NewDate(1, time.January, 1, true),
+				0, 0, -1,
+				nullable.NewDate(0, 0, 0, false),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n := tc.in.AddDate(tc.years, tc.months, tc.days)
+				require.Equal(t, tc.want, n)
+
+				if tc.years == 0 && tc.months == 0 {
+					require.Equal(t, tc.want, tc.in.AddDays(tc.days))
+				}
+			})
+		}
+	})
+}
+
+func TestDate_DaysSince(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Date
+			u    nullable.Date
+			want nullable.Int64
+		}{
+			{
+				"null",
+				nullable.NewDate(0, 0, 0, false),
+				nullable.NewDate(2009, time.January, 3, true),
+				nullable.NewInt64(0, false),
+			},
+			{
+				"same",
+				nullable.NewDate(2009, time.January, 3, true),
+				nullable.NewDate(2009, time.January, 3, true),
+				nullable.NewInt64(0, true),
+			},
+			{
+				"later",
+				nullable.NewDate(2009, time.January, 3, true),
+				nullable.NewDate(1970, time.January, 1, true),
+				nullable.NewInt64(14247, true),
+			},
+			{
+				"earlier",
+				nullable.NewDate(1970, time.January, 1, true),
+				nullable.NewDate(2009, time.January, 3, true),
+				nullable.NewInt64(-14247, true),
+			},
+			{
+				"whole range",
+				nullable.NewDate(9999, time.December, 31, true),
+				nullable.NewDate(1, time.January, 1, true),
+				nullable.NewInt64(3652058, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.DaysSince(tc.u))
+			})
+		}
+	})
+}
+
+func TestDate_Compare(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name          string
+			in            nullable.Date
+			u             nullable.Date
+			want          int
+			before, after nullable.Bool
+		}{
+			{
+				"null, null",
+				nullable.NewDate(0, 0, 0, false),
+				nullable.NewDate(0, 0, 0, false),
+				0,
+				nullable.NewBool(false, false), nullable.NewBool(false, false),
+			},
+			{
+				"null, valid",
+				nullable.NewDate(0, 0, 0, false),
+				nullable.NewDate(1, time.January, 1, true),
+				-1,
+				nullable.NewBool(false, false), nullable.NewBool(false, false),
+			},
+			{
+				"valid, null",
+				nullable.NewDate(1, time.January, 1, true),
+				nullable.NewDate(0, 0, 0, false),
+				1,
+				nullable.NewBool(false, false), nullable.NewBool(false, false),
+			},
+			{
+				"before",
+				nullable.NewDate(2009, time.January, 3, true),
+				nullable.NewDate(2009, time.January, 4, true),
+				-1,
+				nullable.NewBool(true, true), nullable.NewBool(false, true),
+			},
+			{
+				"equal",
+				nullable.NewDate(2009, time.January, 3, true),
+				nullable.NewDate(2009, time.January, 3, true),
+				0,
+				nullable.NewBool(false, true), nullable.NewBool(false, true),
+			},
+			{
+				"after",
+				nullable.NewDate(2010, time.January, 1, true),
+				nullable.NewDate(2009, time.December, 31, true),
+				1,
+				nullable.NewBool(false, true), nullable.NewBool(true, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Compare(tc.u))
+				require.Equal(t, tc.before, tc.in.Before(tc.u))
+				require.Equal(t, tc.after, tc.in.After(tc.u))
+			})
+		}
+	})
+}
+
+func TestDate_Value(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Date
+			want string
+		}{
+			{
+				"year 0",
+				nullable.NewDate(0, time.December, 31, true),
+				"invalid date: year 0 is outside of [1, 9999]",
+			},
+			{
+				"year 10000",
+				nullable.NewDate(10000, time.January, 1, true),
+				"invalid date: year 10000 is outside of [1, 9999]",
+			},
+			{
+				"normalized to year 10000",
+				nullable.NewDate(9999, time.December, 32, true),
+				"invalid date: year 10000 is outside of [1, 9999]",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.Value()
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Date
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewDate(0, 0, 0, false),
+				nil,
+			},
+			{
+				"min",
+				nullable.NewDate(1, time.January, 1, true),
+				"0001-01-01",
+			},
+			{
+				"genesis",
+				nullable.NewDate(2009, time.January, 3, true),
+				"2009-01-03",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestDate_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"int64",
+				int64(0),
+				"unsupported source type: int64",
+			},
+			{
+				"string: empty",
+				"",
+				"invalid source",
+			},
+			{
+				"string: nonexistent date",
+				"2009-02-29",
+				"invalid source",
+			},
+			{
+				"[]byte: with time",
+				[]byte("2009-01-03 00:00:00"),
+				"invalid source",
+			},
+			{
+				"string: year 0",
+				"0000-01-01",
+				"invalid source: invalid date: year 0 is outside of [1, 9999]",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Date
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.Date
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewDate(0, 0, 0, false),
+			},
+			{
+				"time.Time",
+				genesis.In(jst),
+				nullable.NewDate(2009, time.January, 4, true),
+			},
+			{
+				"string",
+				"2009-01-03",
+				nullable.NewDate(2009, time.January, 3, true),
+			},
+			{
+				"[]byte",
+				[]byte("2000-02-29"),
+				nullable.NewDate(2000, time.February, 29, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Date
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDate_MarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Date
+			want string
+		}{
+			{
+				"year 10000",
+				nullable.NewDate(10000, time.January, 1, true),
+				"invalid date: year 10000 is outside of [1, 9999]",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.MarshalJSON()
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Date
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewDate(0, 0, 0, false),
+				[]byte(`null`),
+			},
+			{
+				"genesis",
+				nullable.NewDate(2009, time.January, 3, true),
+				[]byte(`"2009-01-03"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := tc.in.MarshalJSON()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestDate_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"empty",
+				[]byte{},
+				"",
+			},
+			{
+				"number",
+				[]byte(`20090103`),
+				"",
+			},
+			{
+				"string: empty",
+				[]byte(`""`),
+				"invalid source",
+			},
+			{
+				"string: RFC 3339",
+				[]byte(`"2009-01-03T00:00:00Z"`),
+				"invalid source",
+			},
+			{
+				"string: year 0",
+				[]byte(`"0000-12-31"`),
+				"invalid source: invalid date: year 0 is outside of [1, 9999]",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Date
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Date
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewDate(0, 0, 0, false),
+			},
+			{
+				"string",
+				[]byte(`"2009-01-03"`),
+				nullable.NewDate(2009, time.January, 3, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Date
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDate_Generate(t *testing.T) {
+	ns := generate[nullable.Date](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Date
+	}{
+		{
+			"null",
+			nullable.NewDate(0, 0, 0, false),
+		},
+		{
+			"min",
+			nullable.NewDate(1, time.January, 1, true),
+		},
+		{
+			"leap day",
+			nullable.NewDate(2000, time.February, 29, true),
+		},
+		{
+			"max",
+			nullable.NewDate(9999, time.December, 31, true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzDate_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Date](f,
+		[]byte(""),
+		[]byte("2009-02-29"),
+		[]byte("2009-01-03 00:00:00"),
+		[]byte("2009-01-03"),
+		[]byte("2000-02-29"),
+		[]byte("0000-01-01"),
+	)
+}
+
+func FuzzDate_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Date](f,
+		[]byte{},
+		[]byte(`20090103`),
+		[]byte(`""`),
+		[]byte(`"2009-01-03T00:00:00Z"`),
+		[]byte(`null`),
+		[]byte(`"2009-01-03"`),
+	)
+}
@@ -0,0 +1,440 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/m0t0k1ch1-go/bigutil/v3"
+)
+
+// maxDecimalScale bounds the absolute value of the scale of Decimal,
+// so that parsing such as 1e999999999 cannot make formatting allocate without bound.
+// It is the maximum scale of PostgreSQL numeric.
+const maxDecimalScale = 16383
+
+// Decimal represents a nullable arbitrary-precision decimal, Coefficient × 10^-Scale,
+// for NUMERIC and DECIMAL columns.
+// A nil Coefficient is taken as 0.
+//
+// The scale is significant, as in PostgreSQL numeric: 1.50 has a Coefficient of 150 and a Scale of 2,
+// and is formatted with its trailing zero. Use Compare to compare values regardless of their scales.
+//
+// It is encoded in JSON as a string, as JSON numbers lose precision in most JSON decoders;
+// DecimalNumber is encoded as a JSON number instead.
+type Decimal struct {
+	Coefficient *big.Int
+	Scale       int
+	Valid       bool
+}
+
+// NewDecimal returns a new Decimal.
+func NewDecimal(coefficient *big.Int, scale int, valid bool) Decimal {
+	return Decimal{
+		Coefficient: coefficient,
+		Scale:       scale,
+		Valid:       valid,
+	}
+}
+
+// ParseDecimal returns a new valid Decimal parsed from a decimal string such as "-1.50" or "1.5e-18".
+// The scale of the result is the number of fractional digits minus the exponent.
+func ParseDecimal(s string) (Decimal, error) {
+	mantissa, exp := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mantissa = s[:i]
+
+		e := s[i+1:]
+		if len(e) > 6 || strings.TrimLeft(e, "+-") == "" {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+		}
+
+		var err error
+		if exp, err = strconv.Atoi(e); err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+		}
+	}
+
+	digits := strings.TrimLeft(mantissa, "+-")
+	if len(mantissa)-len(digits) > 1 {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	integer, fraction, _ := strings.Cut(digits, ".")
+	if integer+fraction == "" || strings.Trim(integer+fraction, "0123456789") != "" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	scale := len(fraction) - exp
+	if scale < -maxDecimalScale || scale > maxDecimalScale {
+		return Decimal{}, fmt.Errorf("invalid decimal %q: scale out of range", s)
+	}
+
+	c, _ := new(big.Int).SetString(integer+fraction, 10)
+	if strings.HasPrefix(mantissa, "-") {
+		c.Neg(c)
+	}
+
+	return NewDecimal(c, scale, true), nil
+}
+
+// NewDecimalFromUint256 returns a new Decimal from a Uint256 amount in units of 10^-decimals,
+// such as a token amount in its smallest unit, or null if u is invalid.
+func NewDecimalFromUint256(u Uint256, decimals int) Decimal {
+	if !u.Valid {
+		return NewDecimal(nil, 0, false)
+	}
+
+	return NewDecimal(u.Uint256.BigInt(), decimals, true)
+}
+
+// Uint256 returns the value as a Uint256 amount in units of 10^-decimals, or null if invalid.
+// It returns an error if the value is negative, too large, or not a whole number of units;
+// use Round first to discard the excess precision.
+func (n Decimal) Uint256(decimals int) (Uint256, error) {
+	if !n.Valid {
+		return NewUint256(bigutil.Uint256{}, false), nil
+	}
+
+	r := n.Round(decimals, big.ToZero)
+	if r.Compare(n) != 0 {
+		return Uint256{}, fmt.Errorf("%s is not a whole number of 10^-%d units", n.format(), decimals)
+	}
+
+	x256, err := bigutil.NewUint256(r.coefficient())
+	if err != nil {
+		return Uint256{}, err
+	}
+
+	return NewUint256(x256, true), nil
+}
+
+// NullableString returns the value as a String in plain decimal notation, such as "-1.50".
+func (n Decimal) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(n.format(), true)
+}
+
+// Add returns the sum of the value and u, at the larger of their scales, or null if either is invalid.
+func (n Decimal) Add(u Decimal) Decimal {
+	if !n.Valid || !u.Valid {
+		return NewDecimal(nil, 0, false)
+	}
+
+	a, b, scale := alignDecimals(n, u)
+
+	return NewDecimal(a.Add(a, b), scale, true)
+}
+
+// Sub returns the difference of the value and u, at the larger of their scales, or null if either is invalid.
+func (n Decimal) Sub(u Decimal) Decimal {
+	return n.Add(u.Neg())
+}
+
+// Mul returns the product of the value and u, at the sum of their scales, or null if either is invalid.
+func (n Decimal) Mul(u Decimal) Decimal {
+	if !n.Valid || !u.Valid {
+		return NewDecimal(nil, 0, false)
+	}
+
+	return NewDecimal(new(big.Int).Mul(n.coefficient(), u.coefficient()), n.Scale+u.Scale, true)
+}
+
+// Quo returns the quotient of the value and u rounded to scale by mode, or null if either is invalid.
+// It returns an error if u is zero.
+func (n Decimal) Quo(u Decimal, scale int, mode big.RoundingMode) (Decimal, error) {
+	if !n.Valid || !u.Valid {
+		return NewDecimal(nil, 0, false), nil
+	}
+
+	if u.coefficient().Sign() == 0 {
+		return Decimal{}, errDecimalDivisionByZero
+	}
+
+	// n/u × 10^scale = n.Coefficient × 10^(u.Scale+scale-n.Scale) / u.Coefficient
+	num, den := new(big.Int).Set(n.coefficient()), new(big.Int).Set(u.coefficient())
+	if e := u.Scale + scale - n.Scale; e >= 0 {
+		num.Mul(num, pow10(e))
+	} else {
+		den.Mul(den, pow10(-e))
+	}
+
+	return NewDecimal(roundQuo(num, den, mode), scale, true), nil
+}
+
+// Neg returns the negation of the value, or null if invalid.
+func (n Decimal) Neg() Decimal {
+	if !n.Valid {
+		return n
+	}
+
+	return NewDecimal(new(big.Int).Neg(n.coefficient()), n.Scale, true)
+}
+
+// Round returns the value at scale, rounded by mode if scale is less than that of the value,
+// or null if invalid.
+// mode is one of the big.RoundingMode values; big.ToNearestEven rounds half to even, as bankers do,
+// and big.ToNearestAway rounds half away from zero, as PostgreSQL round does.
+func (n Decimal) Round(scale int, mode big.RoundingMode) Decimal {
+	if !n.Valid {
+		return n
+	}
+
+	if scale >= n.Scale {
+		return NewDecimal(new(big.Int).Mul(n.coefficient(), pow10(scale-n.Scale)), scale, true)
+	}
+
+	return NewDecimal(roundQuo(n.coefficient(), pow10(n.Scale-scale), mode), scale, true)
+}
+
+// Compare compares the value with u regardless of their scales, returning -1, 0 or +1.
+// Null sorts before all valid decimals.
+func (n Decimal) Compare(u Decimal) int {
+	if !n.Valid || !u.Valid {
+		return compareValid(n.Valid, u.Valid)
+	}
+
+	a, b, _ := alignDecimals(n, u)
+
+	return a.Cmp(b)
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a string in plain decimal notation, or nil if invalid.
+func (n Decimal) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.format(), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts one of the following:
+//   - string (decimal)
+//   - []byte (decimal)
+//   - int64
+//   - uint64
+//   - float64 (its shortest decimal representation)
+//   - nil
+func (n *Decimal) Scan(src any) error {
+	if src == nil {
+		*n = Decimal{}
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case string:
+		return n.parse(v)
+
+	case []byte:
+		return n.parse(string(v))
+
+	case int64:
+		*n = NewDecimal(big.NewInt(v), 0, true)
+
+		return nil
+
+	case uint64:
+		*n = NewDecimal(new(big.Int).SetUint64(v), 0, true)
+
+		return nil
+
+	case float64:
+		return n.parse(strconv.FormatFloat(v, 'g', -1, 64))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in plain decimal notation, or null if invalid.
+func (n Decimal) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.format())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string or number in decimal notation, or null.
+func (n *Decimal) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = Decimal{}
+
+		return nil
+	}
+
+	if !json.Valid(b) {
+		return fmt.Errorf("invalid JSON: %s", b)
+	}
+
+	if b[0] != '"' {
+		return n.parse(string(b))
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	return n.parse(s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns the value in plain decimal notation, or an empty text if invalid.
+func (n Decimal) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	return []byte(n.format()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts a decimal, or an empty text as null.
+func (n *Decimal) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*n = Decimal{}
+
+		return nil
+	}
+
+	return n.parse(string(b))
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (0, ±1, 10^-18 or the maximum of NUMERIC(38, 18)),
+// or a random decimal with a scale between 0 and 18.
+func (Decimal) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(Decimal{})
+	}
+
+	if s, ok := generateEdge(r, "0", "1", "-1", "0.000000000000000001", "99999999999999999999.999999999999999999"); ok {
+		d, _ := ParseDecimal(s)
+
+		return reflect.ValueOf(d)
+	}
+
+	c := big.NewInt(r.Int63())
+	if r.Intn(2) == 0 {
+		c.Neg(c)
+	}
+
+	return reflect.ValueOf(NewDecimal(c, r.Intn(19), true))
+}
+
+var errDecimalDivisionByZero = errors.New("division by zero")
+
+func (n Decimal) coefficient() *big.Int {
+	if n.Coefficient == nil {
+		return new(big.Int)
+	}
+
+	return n.Coefficient
+}
+
+// format returns the value in plain decimal notation, with exactly Scale fractional digits if positive.
+func (n Decimal) format() string {
+	c := n.coefficient()
+	if n.Scale <= 0 {
+		if c.Sign() == 0 {
+			return "0"
+		}
+
+		return c.String() + strings.Repeat("0", -n.Scale)
+	}
+
+	digits := new(big.Int).Abs(c).String()
+	if len(digits) <= n.Scale {
+		digits = strings.Repeat("0", n.Scale-len(digits)+1) + digits
+	}
+
+	s := digits[:len(digits)-n.Scale] + "." + digits[len(digits)-n.Scale:]
+	if c.Sign() < 0 {
+		s = "-" + s
+	}
+
+	return s
+}
+
+func (n *Decimal) parse(s string) error {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	*n = d
+
+	return nil
+}
+
+// alignDecimals returns the coefficients of two valid decimals at the larger of their scales, and that scale.
+func alignDecimals(a, b Decimal) (*big.Int, *big.Int, int) {
+	scale := max(a.Scale, b.Scale)
+
+	return new(big.Int).Mul(a.coefficient(), pow10(scale-a.Scale)),
+		new(big.Int).Mul(b.coefficient(), pow10(scale-b.Scale)),
+		scale
+}
+
+// roundQuo returns num/den rounded to an integer by mode.
+func roundQuo(num, den *big.Int, mode big.RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	sign := int64(num.Sign() * den.Sign())
+
+	var away bool
+	switch mode {
+
+	case big.ToZero:
+		away = false
+
+	case big.AwayFromZero:
+		away = true
+
+	case big.ToNegativeInf:
+		away = sign < 0
+
+	case big.ToPositiveInf:
+		away = sign > 0
+
+	default:
+		half := new(big.Int).Abs(r)
+		switch half.Lsh(half, 1).Cmp(new(big.Int).Abs(den)) {
+		case 1:
+			away = true
+		case 0:
+			away = mode == big.ToNearestAway || q.Bit(0) == 1
+		}
+	}
+
+	if away {
+		q.Add(q, big.NewInt(sign))
+	}
+
+	return q
+}
+
+// pow10 returns 10^e for a non-negative e.
+func pow10(e int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(e)), nil)
+}
@@ -0,0 +1,1027 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/m0t0k1ch1-go/bigutil/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func mustParseDecimal(s string) nullable.Decimal {
+	d, err := nullable.ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// requireDecimal asserts that got has the validity, value and scale of want.
+func requireDecimal(t *testing.T, want nullable.Decimal, got nullable.Decimal) {
+	t.Helper()
+
+	require.Equal(t, want.Valid, got.Valid)
+	require.Equal(t, want.NullableString(), got.NullableString())
+	if want.Valid {
+		require.Equal(t, want.Scale, got.Scale)
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	var n nullable.Decimal
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextMarshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextUnmarshaler)(nil), &n)
+}
+
+func TestDecimal_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Decimal]{
+		Valid: []nullable.Decimal{
+			mustParseDecimal("0"),
+			mustParseDecimal("-1.50"),
+			mustParseDecimal("0.000000000000000001"),
+			mustParseDecimal("99999999999999999999.999999999999999999"),
+			mustParseDecimal("1e20"),
+		},
+		InvalidScan: []any{
+			"",
+			"NaN",
+			[]byte("1.2.3"),
+			math.Inf(1),
+			true,
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`""`),
+			[]byte(`"Infinity"`),
+			[]byte(`1e999999999`),
+		},
+	})
+}
+
+func TestParseDecimal(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			want string
+		}{
+			{
+				"empty",
+				"",
+				`invalid decimal ""`,
+			},
+			{
+				"sign only",
+				"-",
+				"invalid decimal",
+			},
+			{
+				"point only",
+				".",
+				"invalid decimal",
+			},
+			{
+				"double sign",
+				"--1",
+				"invalid decimal",
+			},
+			{
+				"double point",
+				"1.2.3",
+				"invalid decimal",
+			},
+			{
+				"hex",
+				"0x1",
+				"invalid decimal",
+			},
+			{
+				"NaN",
+				"NaN",
+				"invalid decimal",
+			},
+			{
+				"whitespace",
+				" 1",
+				"invalid decimal",
+			},
+			{
+				"exponent: empty",
+				"1e",
+				"invalid decimal",
+			},
+			{
+				"exponent: huge",
+				"1e999999999",
+				"invalid decimal",
+			},
+			{
+				"scale out of range",
+				"1e-16384",
+				"scale out of range",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := nullable.ParseDecimal(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name      string
+			in        string
+			wantCoeff int64
+			wantScale int
+		}{
+			{
+				"zero",
+				"0",
+				0,
+				0,
+			},
+			{
+				"integer",
+				"150",
+				150,
+				0,
+			},
+			{
+				"trailing zero",
+				"1.50",
+				150,
+				2,
+			},
+			{
+				"negative",
+				"-1.5",
+				-15,
+				1,
+			},
+			{
+				"positive sign",
+				"+1.5",
+				15,
+				1,
+			},
+			{
+				"no integer part",
+				".5",
+				5,
+				1,
+			},
+			{
+				"no fraction part",
+				"5.",
+				5,
+				0,
+			},
+			{
+				"exponent",
+				"1.5e-18",
+				15,
+				19,
+			},
+			{
+				"exponent: positive",
+				"1.5E+3",
+				15,
+				-2,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n, err := nullable.ParseDecimal(tc.in)
+				require.NoError(t, err)
+				require.True(t, n.Valid)
+				require.Equal(t, big.NewInt(tc.wantCoeff).String(), n.Coefficient.String())
+				require.Equal(t, tc.wantScale, n.Scale)
+			})
+		}
+	})
+}
+
+func TestNewDecimalFromUint256(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name     string
+			in       nullable.Uint256
+			decimals int
+			want     nullable.Decimal
+		}{
+			{
+				"null",
+				nullable.NewUint256(bigutil.Uint256{}, false),
+				18,
+				nullable.NewDecimal(nil, 0, false),
+			},
+			{
+				"zero",
+				nullable.NewUint256(bigutil.NewUint256FromUint64(0), true),
+				18,
+				mustParseDecimal("0.000000000000000000"),
+			},
+			{
+				"1.5 ether",
+				nullable.NewUint256(bigutil.NewUint256FromUint64(1_500_000_000_000_000_000), true),
+				18,
+				mustParseDecimal("1.500000000000000000"),
+			},
+			{
+				"6 decimals",
+				nullable.NewUint256(bigutil.NewUint256FromUint64(1_234_567), true),
+				6,
+				mustParseDecimal("1.234567"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n := nullable.NewDecimalFromUint256(tc.in, tc.decimals)
+				requireDecimal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDecimal_Uint256(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name     string
+			in       nullable.Decimal
+			decimals int
+			want     string
+		}{
+			{
+				"excess precision",
+				mustParseDecimal("1.0000001"),
+				6,
+				"not a whole number of 10^-6 units",
+			},
+			{
+				"negative",
+				mustParseDecimal("-1"),
+				6,
+				"",
+			},
+			{
+				"overflow",
+				mustParseDecimal("1e60"),
+				18,
+				"",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.Uint256(tc.decimals)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name     string
+			in       nullable.Decimal
+			decimals int
+			want     nullable.Uint256
+		}{
+			{
+				"null",
+				nullable.NewDecimal(nil, 0, false),
+				18,
+				nullable.NewUint256(bigutil.Uint256{}, false),
+			},
+			{
+				"1.5 ether",
+				mustParseDecimal("1.5"),
+				18,
+				nullable.NewUint256(bigutil.NewUint256FromUint64(1_500_000_000_000_000_000), true),
+			},
+			{
+				"trailing zeros",
+				mustParseDecimal("1.23456700"),
+				6,
+				nullable.NewUint256(bigutil.NewUint256FromUint64(1_234_567), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				u, err := tc.in.Uint256(tc.decimals)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.Valid, u.Valid)
+				require.Equal(t, tc.want.Uint256.BigInt(), u.Uint256.BigInt())
+			})
+		}
+	})
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	null := nullable.NewDecimal(nil, 0, false)
+
+	t.Run("Add", func(t *testing.T) {
+		requireDecimal(t, mustParseDecimal("1.75"), mustParseDecimal("1.5").Add(mustParseDecimal("0.25")))
+		requireDecimal(t, mustParseDecimal("-0.5"), mustParseDecimal("1").Add(mustParseDecimal("-1.5")))
+		requireDecimal(t, mustParseDecimal("100.1"), mustParseDecimal("1e2").Add(mustParseDecimal("0.1")))
+		requireDecimal(t, null, mustParseDecimal("1").Add(null))
+		requireDecimal(t, null, null.Add(mustParseDecimal("1")))
+	})
+
+	t.Run("Sub", func(t *testing.T) {
+		requireDecimal(t, mustParseDecimal("1.25"), mustParseDecimal("1.5").Sub(mustParseDecimal("0.25")))
+		requireDecimal(t, mustParseDecimal("0.0"), mustParseDecimal("1.5").Sub(mustParseDecimal("1.5")))
+		requireDecimal(t, null, mustParseDecimal("1").Sub(null))
+	})
+
+	t.Run("Mul", func(t *testing.T) {
+		requireDecimal(t, mustParseDecimal("0.375"), mustParseDecimal("1.5").Mul(mustParseDecimal("0.25")))
+		requireDecimal(t, mustParseDecimal("-0.375"), mustParseDecimal("-1.5").Mul(mustParseDecimal("0.25")))
+		requireDecimal(t, null, null.Mul(mustParseDecimal("1")))
+	})
+
+	t.Run("Quo", func(t *testing.T) {
+		tcs := []struct {
+			name  string
+			n     nullable.Decimal
+			u     nullable.Decimal
+			scale int
+			mode  big.RoundingMode
+			want  nullable.Decimal
+		}{
+			{
+				"exact",
+				mustParseDecimal("1.5"),
+				mustParseDecimal("0.25"),
+				2,
+				big.ToNearestEven,
+				mustParseDecimal("6.00"),
+			},
+			{
+				"rounded",
+				mustParseDecimal("1"),
+				mustParseDecimal("3"),
+				4,
+				big.ToNearestEven,
+				mustParseDecimal("0.3333"),
+			},
+			{
+				"rounded: negative",
+				mustParseDecimal("-2"),
+				mustParseDecimal("3"),
+				2,
+				big.ToNearestEven,
+				mustParseDecimal("-0.67"),
+			},
+			{
+				"rounded: negative divisor",
+				mustParseDecimal("2"),
+				mustParseDecimal("-3"),
+				2,
+				big.ToZero,
+				mustParseDecimal("-0.66"),
+			},
+			{
+				"negative scale",
+				mustParseDecimal("12345"),
+				mustParseDecimal("1"),
+				-2,
+				big.ToNearestAway,
+				mustParseDecimal("123e2"),
+			},
+			{
+				"null",
+				mustParseDecimal("1"),
+				null,
+				2,
+				big.ToNearestEven,
+				null,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n, err := tc.n.Quo(tc.u, tc.scale, tc.mode)
+				require.NoError(t, err)
+				requireDecimal(t, tc.want, n)
+			})
+		}
+	})
+
+	t.Run("Quo: division by zero", func(t *testing.T) {
+		_, err := mustParseDecimal("1").Quo(mustParseDecimal("0.00"), 2, big.ToNearestEven)
+		require.ErrorContains(t, err, "division by zero")
+	})
+
+	t.Run("Neg", func(t *testing.T) {
+		requireDecimal(t, mustParseDecimal("-1.50"), mustParseDecimal("1.50").Neg())
+		requireDecimal(t, mustParseDecimal("1.50"), mustParseDecimal("-1.50").Neg())
+		requireDecimal(t, null, null.Neg())
+	})
+}
+
+func TestDecimal_Round(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name  string
+			in    string
+			scale int
+			want  map[big.RoundingMode]string
+		}{
+			{
+				"half",
+				"2.5",
+				0,
+				map[big.RoundingMode]string{
+					big.ToNearestEven: "2",
+					big.ToNearestAway: "3",
+					big.ToZero:        "2",
+					big.AwayFromZero:  "3",
+					big.ToNegativeInf: "2",
+					big.ToPositiveInf: "3",
+				},
+			},
+			{
+				"half: odd",
+				"3.5",
+				0,
+				map[big.RoundingMode]string{
+					big.ToNearestEven: "4",
+					big.ToNearestAway: "4",
+					big.ToZero:        "3",
+					big.AwayFromZero:  "4",
+					big.ToNegativeInf: "3",
+					big.ToPositiveInf: "4",
+				},
+			},
+			{
+				"half: negative",
+				"-2.5",
+				0,
+				map[big.RoundingMode]string{
+					big.ToNearestEven: "-2",
+					big.ToNearestAway: "-3",
+					big.ToZero:        "-2",
+					big.AwayFromZero:  "-3",
+					big.ToNegativeInf: "-3",
+					big.ToPositiveInf: "-2",
+				},
+			},
+			{
+				"below half",
+				"-1.249",
+				1,
+				map[big.RoundingMode]string{
+					big.ToNearestEven: "-1.2",
+					big.ToNearestAway: "-1.2",
+					big.ToZero:        "-1.2",
+					big.AwayFromZero:  "-1.3",
+					big.ToNegativeInf: "-1.3",
+					big.ToPositiveInf: "-1.2",
+				},
+			},
+			{
+				"above half",
+				"1.251",
+				1,
+				map[big.RoundingMode]string{
+					big.ToNearestEven: "1.3",
+					big.ToNearestAway: "1.3",
+					big.ToZero:        "1.2",
+					big.AwayFromZero:  "1.3",
+					big.ToNegativeInf: "1.2",
+					big.ToPositiveInf: "1.3",
+				},
+			},
+			{
+				"exact",
+				"1.20",
+				1,
+				map[big.RoundingMode]string{
+					big.ToNearestEven: "1.2",
+					big.AwayFromZero:  "1.2",
+				},
+			},
+			{
+				"larger scale",
+				"1.2",
+				3,
+				map[big.RoundingMode]string{
+					big.ToNearestEven: "1.200",
+					big.ToZero:        "1.200",
+				},
+			},
+			{
+				"negative scale",
+				"1250",
+				-2,
+				map[big.RoundingMode]string{
+					big.ToNearestEven: "1200",
+					big.ToNearestAway: "1300",
+				},
+			},
+		}
+
+		for _, tc := range tcs {
+			for mode, want := range tc.want {
+				t.Run(tc.name+": "+mode.String(), func(t *testing.T) {
+					n := mustParseDecimal(tc.in).Round(tc.scale, mode)
+					require.Equal(t, nullable.NewString(want, true), n.NullableString())
+					require.Equal(t, tc.scale, n.Scale)
+				})
+			}
+		}
+	})
+
+	t.Run("success: null", func(t *testing.T) {
+		n := nullable.NewDecimal(nil, 0, false).Round(2, big.ToNearestEven)
+		require.False(t, n.Valid)
+	})
+}
+
+func TestDecimal_Compare(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			n    nullable.Decimal
+			u    nullable.Decimal
+			want int
+		}{
+			{
+				"null: both",
+				nullable.NewDecimal(nil, 0, false),
+				nullable.NewDecimal(nil, 0, false),
+				0,
+			},
+			{
+				"null: value",
+				nullable.NewDecimal(nil, 0, false),
+				mustParseDecimal("-1"),
+				-1,
+			},
+			{
+				"null: u",
+				mustParseDecimal("-1"),
+				nullable.NewDecimal(nil, 0, false),
+				1,
+			},
+			{
+				"equal: different scales",
+				mustParseDecimal("1.50"),
+				mustParseDecimal("1.5"),
+				0,
+			},
+			{
+				"equal: nil coefficient",
+				nullable.NewDecimal(nil, 2, true),
+				mustParseDecimal("0"),
+				0,
+			},
+			{
+				"less",
+				mustParseDecimal("1.49"),
+				mustParseDecimal("1.5"),
+				-1,
+			},
+			{
+				"greater",
+				mustParseDecimal("1e2"),
+				mustParseDecimal("99.99"),
+				1,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.n.Compare(tc.u))
+			})
+		}
+	})
+}
+
+func TestDecimal_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Decimal
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewDecimal(nil, 0, false),
+				nullable.NewString("", false),
+			},
+			{
+				"nil coefficient",
+				nullable.NewDecimal(nil, 2, true),
+				nullable.NewString("0.00", true),
+			},
+			{
+				"fraction",
+				nullable.NewDecimal(big.NewInt(-5), 3, true),
+				nullable.NewString("-0.005", true),
+			},
+			{
+				"trailing zeros",
+				nullable.NewDecimal(big.NewInt(150), 2, true),
+				nullable.NewString("1.50", true),
+			},
+			{
+				"negative scale",
+				nullable.NewDecimal(big.NewInt(-15), -2, true),
+				nullable.NewString("-1500", true),
+			},
+			{
+				"negative scale: zero",
+				nullable.NewDecimal(big.NewInt(0), -2, true),
+				nullable.NewString("0", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.NullableString())
+			})
+		}
+	})
+}
+
+func TestDecimal_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Decimal
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewDecimal(nil, 0, false),
+				nil,
+			},
+			{
+				"NUMERIC(38, 18)",
+				mustParseDecimal("99999999999999999999.999999999999999999"),
+				"99999999999999999999.999999999999999999",
+			},
+			{
+				"exponent",
+				mustParseDecimal("-1.5e-3"),
+				"-0.0015",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestDecimal_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"bool",
+				true,
+				"unsupported source type: bool",
+			},
+			{
+				"string: NaN",
+				"NaN",
+				"invalid source",
+			},
+			{
+				"float64: infinity",
+				math.Inf(-1),
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Decimal
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.Decimal
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewDecimal(nil, 0, false),
+			},
+			{
+				"string",
+				"-1.50",
+				mustParseDecimal("-1.50"),
+			},
+			{
+				"[]byte",
+				[]byte("99999999999999999999.999999999999999999"),
+				mustParseDecimal("99999999999999999999.999999999999999999"),
+			},
+			{
+				"int64",
+				int64(math.MinInt64),
+				mustParseDecimal("-9223372036854775808"),
+			},
+			{
+				"uint64",
+				uint64(math.MaxUint64),
+				mustParseDecimal("18446744073709551615"),
+			},
+			{
+				"float64",
+				0.1,
+				mustParseDecimal("0.1"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Decimal
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				requireDecimal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDecimal_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Decimal
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewDecimal(nil, 0, false),
+				[]byte(`null`),
+			},
+			{
+				"valid",
+				mustParseDecimal("-1.50"),
+				[]byte(`"-1.50"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestDecimal_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"invalid JSON",
+				[]byte(`01`),
+				"invalid JSON",
+			},
+			{
+				"boolean",
+				[]byte(`true`),
+				"invalid source",
+			},
+			{
+				"string: empty",
+				[]byte(`""`),
+				"invalid source",
+			},
+			{
+				"number: huge exponent",
+				[]byte(`1e999999999`),
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Decimal
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Decimal
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewDecimal(nil, 0, false),
+			},
+			{
+				"string",
+				[]byte(`"99999999999999999999.999999999999999999"`),
+				mustParseDecimal("99999999999999999999.999999999999999999"),
+			},
+			{
+				"number",
+				[]byte(`99999999999999999999.999999999999999999`),
+				mustParseDecimal("99999999999999999999.999999999999999999"),
+			},
+			{
+				"number: exponent",
+				[]byte(`-1.5e-3`),
+				mustParseDecimal("-0.0015"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Decimal
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				requireDecimal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDecimal_MarshalText(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Decimal
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewDecimal(nil, 0, false),
+				[]byte{},
+			},
+			{
+				"valid",
+				mustParseDecimal("-1.50"),
+				[]byte("-1.50"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := tc.in.MarshalText()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestDecimal_UnmarshalText(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		var n nullable.Decimal
+		err := n.UnmarshalText([]byte("invalid"))
+		require.ErrorContains(t, err, "invalid source")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Decimal
+		}{
+			{
+				"empty",
+				[]byte{},
+				nullable.NewDecimal(nil, 0, false),
+			},
+			{
+				"valid",
+				[]byte("-1.50"),
+				mustParseDecimal("-1.50"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Decimal
+				err := n.UnmarshalText(tc.in)
+				require.NoError(t, err)
+				requireDecimal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDecimal_Generate(t *testing.T) {
+	ns := generate[nullable.Decimal](t, 1000)
+
+	ss := make([]nullable.String, len(ns))
+	for i, n := range ns {
+		ss[i] = n.NullableString()
+	}
+
+	tcs := []struct {
+		name string
+		want nullable.String
+	}{
+		{
+			"null",
+			nullable.NewString("", false),
+		},
+		{
+			"0",
+			nullable.NewString("0", true),
+		},
+		{
+			"1",
+			nullable.NewString("1", true),
+		},
+		{
+			"-1",
+			nullable.NewString("-1", true),
+		},
+		{
+			"10^-18",
+			nullable.NewString("0.000000000000000001", true),
+		},
+		{
+			"max of NUMERIC(38, 18)",
+			nullable.NewString("99999999999999999999.999999999999999999", true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ss, tc.want)
+		})
+	}
+}
+
+func FuzzDecimal_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Decimal](f,
+		[]byte("0"),
+		[]byte("-1.50"),
+		[]byte("1.5e-18"),
+		[]byte("99999999999999999999.999999999999999999"),
+		[]byte("NaN"),
+	)
+}
+
+func FuzzDecimal_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Decimal](f,
+		[]byte(`true`),
+		[]byte(`""`),
+		[]byte(`1e999999999`),
+		[]byte(`null`),
+		[]byte(`"-1.50"`),
+		[]byte(`-1.5e-3`),
+	)
+}
+
+func FuzzDecimal_UnmarshalText(f *testing.F) {
+	nullabletest.FuzzText[nullable.Decimal](f,
+		[]byte(""),
+		[]byte("invalid"),
+		[]byte("-1.50"),
+		[]byte("1E+3"),
+	)
+}
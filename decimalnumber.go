@@ -0,0 +1,69 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"math/big"
+	"math/rand"
+	"reflect"
+)
+
+// DecimalNumber represents a nullable arbitrary-precision decimal encoded in JSON as a number.
+// It is stored and scanned exactly as Decimal, and converts to and from it.
+// Use it only with JSON decoders that keep the precision of numbers.
+type DecimalNumber Decimal
+
+// NewDecimalNumber returns a new DecimalNumber.
+func NewDecimalNumber(coefficient *big.Int, scale int, valid bool) DecimalNumber {
+	return DecimalNumber(NewDecimal(coefficient, scale, valid))
+}
+
+// NullableString returns the value as a String in plain decimal notation.
+func (n DecimalNumber) NullableString() String {
+	return Decimal(n).NullableString()
+}
+
+// Value implements driver.Valuer.
+// It returns the same driver.Value as Decimal.Value.
+func (n DecimalNumber) Value() (driver.Value, error) {
+	return Decimal(n).Value()
+}
+
+// Scan implements sql.Scanner.
+// It accepts the same sources as Decimal.Scan.
+func (n *DecimalNumber) Scan(src any) error {
+	return (*Decimal)(n).Scan(src)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON number in plain decimal notation, or null if invalid.
+func (n DecimalNumber) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return []byte(Decimal(n).format()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts the same JSON values as Decimal.UnmarshalJSON.
+func (n *DecimalNumber) UnmarshalJSON(b []byte) error {
+	return (*Decimal)(n).UnmarshalJSON(b)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns the same text as Decimal.MarshalText.
+func (n DecimalNumber) MarshalText() ([]byte, error) {
+	return Decimal(n).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts the same texts as Decimal.UnmarshalText.
+func (n *DecimalNumber) UnmarshalText(b []byte) error {
+	return (*Decimal)(n).UnmarshalText(b)
+}
+
+// Generate implements quick.Generator.
+// It returns the same values as Decimal.Generate.
+func (DecimalNumber) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(DecimalNumber(Decimal{}.Generate(r, size).Interface().(Decimal)))
+}
@@ -0,0 +1,123 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestDecimalNumber(t *testing.T) {
+	var n nullable.DecimalNumber
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextMarshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextUnmarshaler)(nil), &n)
+}
+
+func TestDecimalNumber_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.DecimalNumber]{
+		Valid: []nullable.DecimalNumber{
+			nullable.DecimalNumber(mustParseDecimal("0")),
+			nullable.DecimalNumber(mustParseDecimal("-1.50")),
+			nullable.DecimalNumber(mustParseDecimal("99999999999999999999.999999999999999999")),
+			nullable.DecimalNumber(mustParseDecimal("1e20")),
+		},
+		InvalidScan: []any{
+			"",
+			"NaN",
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`"Infinity"`),
+		},
+	})
+}
+
+func TestDecimalNumber_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.DecimalNumber
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewDecimalNumber(nil, 0, false),
+				[]byte(`null`),
+			},
+			{
+				"zero coefficient",
+				nullable.NewDecimalNumber(nil, 2, true),
+				[]byte(`0.00`),
+			},
+			{
+				"negative",
+				nullable.NewDecimalNumber(big.NewInt(-150), 2, true),
+				[]byte(`-1.50`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestDecimalNumber_UnmarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Decimal
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewDecimal(nil, 0, false),
+			},
+			{
+				"number",
+				[]byte(`-1.50`),
+				mustParseDecimal("-1.50"),
+			},
+			{
+				"string",
+				[]byte(`"-1.50"`),
+				mustParseDecimal("-1.50"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.DecimalNumber
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				requireDecimal(t, tc.want, nullable.Decimal(n))
+			})
+		}
+	})
+}
+
+func FuzzDecimalNumber_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.DecimalNumber](f,
+		[]byte(`true`),
+		[]byte(`null`),
+		[]byte(`-1.50`),
+		[]byte(`"1e20"`),
+		[]byte(`0.000000000000000001`),
+	)
+}
@@ -0,0 +1,514 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// Duration represents a nullable time.Duration.
+// It is encoded in JSON as a Go duration string such as "1h30m0s", and stored as an int64 of nanoseconds
+// for BIGINT columns. DurationNanoseconds, DurationSeconds and DurationISO8601 encode it in other formats.
+type Duration struct {
+	Duration time.Duration
+	Valid    bool
+}
+
+// NewDuration returns a new Duration.
+func NewDuration(d time.Duration, valid bool) Duration {
+	return Duration{
+		Duration: d,
+		Valid:    valid,
+	}
+}
+
+// NullableString returns the value as a String in Go duration format.
+func (n Duration) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(n.Duration.String(), true)
+}
+
+// Value implements driver.Valuer.
+// It returns the value as an int64 of nanoseconds, or nil if invalid.
+func (n Duration) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return int64(n.Duration), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts:
+//   - int64 (nanoseconds)
+//   - string (Go duration, ISO 8601 duration or PostgreSQL interval)
+//   - []byte (Go duration, ISO 8601 duration or PostgreSQL interval)
+//   - nil
+func (n *Duration) Scan(src any) error {
+	return n.scan(src, time.Nanosecond)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in Go duration format, or null if invalid.
+func (n Duration) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.Duration.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string (Go duration, ISO 8601 duration or PostgreSQL interval),
+// a JSON number of nanoseconds, or null.
+func (n *Duration) UnmarshalJSON(b []byte) error {
+	return n.unmarshalJSON(b, time.Nanosecond)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns the value in Go duration format, or an empty text if invalid.
+func (n Duration) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	return []byte(n.Duration.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts a Go duration, an ISO 8601 duration or a PostgreSQL interval, or an empty text as null.
+func (n *Duration) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		n.Duration, n.Valid = 0, false
+
+		return nil
+	}
+
+	return n.parse(string(b))
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It returns the value as a string in Go duration format, or nil if invalid.
+func (n Duration) MarshalYAML() (any, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Duration.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It accepts a YAML string (Go duration, ISO 8601 duration or PostgreSQL interval),
+// a YAML number of nanoseconds, or null.
+func (n *Duration) UnmarshalYAML(value *yaml.Node) error {
+	return n.unmarshalYAML(value, time.Nanosecond)
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (0, ±1ns, 1s, 1h30m, 24h, or the minimum or maximum time.Duration),
+// or a random duration.
+func (Duration) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewDuration(0, false))
+	}
+
+	if d, ok := generateEdge[time.Duration](r, 0, 1, -1, time.Second, 90*time.Minute, 24*time.Hour, math.MinInt64, math.MaxInt64); ok {
+		return reflect.ValueOf(NewDuration(d, true))
+	}
+
+	return reflect.ValueOf(NewDuration(time.Duration(r.Uint64()), true))
+}
+
+// scan implements Scan, taking int64 sources in unit.
+func (n *Duration) scan(src any, unit time.Duration) error {
+	if src == nil {
+		n.Duration, n.Valid = 0, false
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case int64:
+		if v > math.MaxInt64/int64(unit) || v < math.MinInt64/int64(unit) {
+			return fmt.Errorf("invalid source: %d %s overflows time.Duration", v, durationUnitNames[unit])
+		}
+
+		n.Duration, n.Valid = time.Duration(v)*unit, true
+
+		return nil
+
+	case string:
+		return n.parse(v)
+
+	case []byte:
+		return n.parse(string(v))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// unmarshalJSON implements UnmarshalJSON, taking JSON numbers in unit.
+func (n *Duration) unmarshalJSON(b []byte, unit time.Duration) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.Duration, n.Valid = 0, false
+
+		return nil
+	}
+
+	if !json.Valid(b) {
+		return fmt.Errorf("invalid JSON: %s", b)
+	}
+
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+
+	switch v := v.(type) {
+
+	case string:
+		return n.parse(v)
+
+	case json.Number:
+		return n.parseNumber(v.String(), unit)
+
+	default:
+		return fmt.Errorf("invalid JSON: %s", b)
+	}
+}
+
+// unmarshalNumberText implements UnmarshalText for the formats encoding durations as numbers in unit.
+// It also accepts a Go duration, an ISO 8601 duration or a PostgreSQL interval.
+func (n *Duration) unmarshalNumberText(b []byte, unit time.Duration) error {
+	if len(b) == 0 {
+		n.Duration, n.Valid = 0, false
+
+		return nil
+	}
+
+	if strings.Trim(string(b), "+-0123456789.eE") == "" {
+		return n.parseNumber(string(b), unit)
+	}
+
+	return n.parse(string(b))
+}
+
+// unmarshalYAML implements UnmarshalYAML, taking YAML numbers in unit.
+func (n *Duration) unmarshalYAML(value *yaml.Node, unit time.Duration) error {
+	switch value.Tag {
+
+	case "!!null":
+		n.Duration, n.Valid = 0, false
+
+		return nil
+
+	case "!!int", "!!float":
+		return n.parseNumber(value.Value, unit)
+
+	default:
+		var s string
+		if err := value.Decode(&s); err != nil {
+			return err
+		}
+
+		return n.parse(s)
+	}
+}
+
+func (n *Duration) parse(s string) error {
+	d, err := parseDuration(s)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	n.Duration, n.Valid = d, true
+
+	return nil
+}
+
+func (n *Duration) parseNumber(s string, unit time.Duration) error {
+	if strings.Trim(s, "+-0123456789.eE") != "" {
+		return fmt.Errorf("invalid source: invalid number %q", s)
+	}
+
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		// Bound the exponent; big.Rat would otherwise expand 1e999999999 in full.
+		if exp, err := strconv.Atoi(s[i+1:]); err != nil || exp < -maxDurationExponent || exp > maxDurationExponent {
+			return fmt.Errorf("invalid source: invalid number %q", s)
+		}
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return fmt.Errorf("invalid source: invalid number %q", s)
+	}
+
+	d, err := durationFromRat(r.Mul(r, new(big.Rat).SetInt64(int64(unit))))
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	n.Duration, n.Valid = d, true
+
+	return nil
+}
+
+// parseDuration parses a Go duration, an ISO 8601 duration or a PostgreSQL interval.
+func parseDuration(s string) (time.Duration, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+
+	if strings.HasPrefix(strings.TrimLeft(s, "+-"), "P") {
+		return parseISO8601Duration(s)
+	}
+
+	return parsePostgresInterval(s)
+}
+
+// durationUnitNames maps the units of numeric duration formats to their names in error messages.
+var durationUnitNames = map[time.Duration]string{
+	time.Nanosecond: "nanoseconds",
+	time.Second:     "seconds",
+}
+
+// maxDurationExponent bounds the exponent of numbers accepted as durations.
+// Any larger exponent overflows time.Duration or yields fractional nanoseconds for all practical mantissas.
+const maxDurationExponent = 64
+
+var errNoFixedDuration = errors.New("years and months have no fixed duration")
+
+// parseISO8601Duration parses an ISO 8601 duration such as "PT1H30M" or "-P1DT0.5S".
+// Weeks and days are taken as 7 and 1 times 24 hours; years and months must be zero.
+// Only the seconds may be fractional.
+func parseISO8601Duration(s string) (time.Duration, error) {
+	rest, neg := strings.CutPrefix(s, "-")
+	if !neg {
+		rest = strings.TrimPrefix(rest, "+")
+	}
+
+	rest, ok := strings.CutPrefix(rest, "P")
+	if !ok || rest == "" || strings.HasSuffix(rest, "T") {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q", s)
+	}
+
+	units := map[byte]time.Duration{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour, 'Y': 0, 'M': 0}
+	order := "YMWD"
+
+	total, timePart := new(big.Rat), false
+	for rest != "" {
+		if rest[0] == 'T' {
+			if timePart {
+				return 0, fmt.Errorf("invalid ISO 8601 duration %q", s)
+			}
+			timePart = true
+			units = map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}
+			order = "HMS"
+			rest = rest[1:]
+			if rest == "" {
+				return 0, fmt.Errorf("invalid ISO 8601 duration %q", s)
+			}
+		}
+
+		i := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if i <= 0 {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", s)
+		}
+
+		num, designator := strings.ReplaceAll(rest[:i], ",", "."), rest[i]
+		rest = rest[i+1:]
+
+		j := strings.IndexByte(order, designator)
+		if j < 0 {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", s)
+		}
+		order = order[j+1:]
+		if designator != 'S' && strings.Contains(num, ".") {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q: only seconds may be fractional", s)
+		}
+
+		r, ok := new(big.Rat).SetString(num)
+		if !ok {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", s)
+		}
+		if units[designator] == 0 {
+			if r.Sign() != 0 {
+				return 0, fmt.Errorf("invalid ISO 8601 duration %q: %w", s, errNoFixedDuration)
+			}
+
+			continue
+		}
+
+		total.Add(total, r.Mul(r, new(big.Rat).SetInt64(int64(units[designator]))))
+	}
+
+	if neg {
+		total.Neg(total)
+	}
+
+	return durationFromRat(total)
+}
+
+// parsePostgresInterval parses PostgreSQL interval output in the default postgres style,
+// such as "1 day 02:03:04.5" or "-00:00:01". Days are taken as 24 hours; years and months must be zero.
+func parsePostgresInterval(s string) (time.Duration, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("invalid interval %q", s)
+	}
+
+	total := new(big.Rat)
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+
+		if strings.Contains(f, ":") {
+			d, err := parseClock(f)
+			if err != nil {
+				return 0, fmt.Errorf("invalid interval %q", s)
+			}
+			total.Add(total, d)
+
+			continue
+		}
+
+		if i+1 >= len(fields) {
+			return 0, fmt.Errorf("invalid interval %q", s)
+		}
+
+		v, err := strconv.ParseInt(f, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid interval %q", s)
+		}
+
+		i++
+		switch strings.TrimSuffix(fields[i], "s") {
+		case "year", "mon":
+			if v != 0 {
+				return 0, fmt.Errorf("invalid interval %q: %w", s, errNoFixedDuration)
+			}
+		case "day":
+			total.Add(total, new(big.Rat).SetInt(new(big.Int).Mul(big.NewInt(v), big.NewInt(int64(24*time.Hour)))))
+		default:
+			return 0, fmt.Errorf("invalid interval %q", s)
+		}
+	}
+
+	return durationFromRat(total)
+}
+
+// parseClock parses [+-]HH:MM:SS[.ffffff] into nanoseconds. HH may exceed 23.
+func parseClock(s string) (*big.Rat, error) {
+	rest, neg := strings.CutPrefix(s, "-")
+	if !neg {
+		rest = strings.TrimPrefix(rest, "+")
+	}
+
+	parts := strings.Split(rest, ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid clock %q", s)
+	}
+
+	total := new(big.Rat)
+	for i, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		p := parts[i]
+		if p == "" || strings.Trim(p, "0123456789.") != "" || (i < 2 && strings.Contains(p, ".")) {
+			return nil, fmt.Errorf("invalid clock %q", s)
+		}
+
+		r, ok := new(big.Rat).SetString(p)
+		if !ok {
+			return nil, fmt.Errorf("invalid clock %q", s)
+		}
+		total.Add(total, r.Mul(r, new(big.Rat).SetInt64(int64(unit))))
+	}
+
+	if neg {
+		total.Neg(total)
+	}
+
+	return total, nil
+}
+
+// durationFromRat converts a number of nanoseconds to a time.Duration,
+// rejecting fractional nanoseconds and overflow.
+func durationFromRat(r *big.Rat) (time.Duration, error) {
+	if !r.IsInt() {
+		return 0, errors.New("duration has fractional nanoseconds")
+	}
+
+	if !r.Num().IsInt64() {
+		return 0, errors.New("duration overflows time.Duration")
+	}
+
+	return time.Duration(r.Num().Int64()), nil
+}
+
+// formatISO8601Duration formats d as an ISO 8601 duration in hours, minutes and seconds,
+// such as "PT1H30M" or "-PT0.5S".
+func formatISO8601Duration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+
+	b.WriteString("PT")
+
+	if h := u / uint64(time.Hour); h > 0 {
+		b.WriteString(strconv.FormatUint(h, 10) + "H")
+	}
+	if m := u % uint64(time.Hour) / uint64(time.Minute); m > 0 {
+		b.WriteString(strconv.FormatUint(m, 10) + "M")
+	}
+	if ns := u % uint64(time.Minute); ns > 0 {
+		b.WriteString(formatDecimal(ns, 9) + "S")
+	}
+
+	return b.String()
+}
+
+// formatSeconds formats d as an exact decimal number of seconds, such as "5400" or "-0.5".
+func formatSeconds(d time.Duration) string {
+	u := uint64(d)
+	if d < 0 {
+		return "-" + formatDecimal(-u, 9)
+	}
+
+	return formatDecimal(u, 9)
+}
+
+// formatDecimal formats u / 10^scale as a decimal without trailing zeros.
+func formatDecimal(u uint64, scale int) string {
+	s := fmt.Sprintf("%0*d", scale+1, u)
+
+	i, f := s[:len(s)-scale], strings.TrimRight(s[len(s)-scale:], "0")
+	if f == "" {
+		return i
+	}
+
+	return i + "." + f
+}
@@ -0,0 +1,659 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestDuration(t *testing.T) {
+	var n nullable.Duration
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextMarshaler)(nil), &n)
+	require.Implements(t, (*yaml.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextUnmarshaler)(nil), &n)
+	require.Implements(t, (*yaml.Unmarshaler)(nil), &n)
+}
+
+func TestDuration_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Duration]{
+		Valid: []nullable.Duration{
+			nullable.NewDuration(0, true),
+			nullable.NewDuration(90*time.Minute, true),
+			nullable.NewDuration(-time.Nanosecond, true),
+			nullable.NewDuration(math.MinInt64, true),
+			nullable.NewDuration(math.MaxInt64, true),
+		},
+		InvalidScan: []any{
+			"",
+			"1 mon",
+			[]byte("P1Y"),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`""`),
+			[]byte(`0.5`),
+			[]byte(`9223372036854775808`),
+		},
+	})
+}
+
+func TestDuration_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Duration
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewDuration(0, false),
+				nullable.NewString("", false),
+			},
+			{
+				"zero",
+				nullable.NewDuration(0, true),
+				nullable.NewString("0s", true),
+			},
+			{
+				"non-zero",
+				nullable.NewDuration(90*time.Minute, true),
+				nullable.NewString("1h30m0s", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				s := tc.in.NullableString()
+				require.Equal(t, tc.want, s)
+			})
+		}
+	})
+}
+
+func TestDuration_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Duration
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewDuration(0, false),
+				nil,
+			},
+			{
+				"non-zero",
+				nullable.NewDuration(90*time.Minute, true),
+				int64(5400000000000),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestDuration_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"float64",
+				float64(0),
+				"unsupported source type: float64",
+			},
+			{
+				"string: empty",
+				"",
+				"invalid source",
+			},
+			{
+				"string: no unit",
+				"1.5",
+				"invalid source",
+			},
+			{
+				"string: ISO 8601 with months",
+				"P1M",
+				"no fixed duration",
+			},
+			{
+				"string: ISO 8601 with fractional minutes",
+				"PT1.5M",
+				"only seconds may be fractional",
+			},
+			{
+				"string: ISO 8601 with trailing T",
+				"P1DT",
+				"invalid ISO 8601 duration",
+			},
+			{
+				"string: ISO 8601 out of order",
+				"PT1S1H",
+				"invalid ISO 8601 duration",
+			},
+			{
+				"string: ISO 8601 with fractional nanoseconds",
+				"PT0.0000000001S",
+				"fractional nanoseconds",
+			},
+			{
+				"string: ISO 8601 overflow",
+				"PT2562048H",
+				"overflows",
+			},
+			{
+				"[]byte: interval with months",
+				[]byte("1 mon 00:00:00"),
+				"no fixed duration",
+			},
+			{
+				"[]byte: interval with unknown unit",
+				[]byte("1 fortnight"),
+				"invalid interval",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Duration
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.Duration
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewDuration(0, false),
+			},
+			{
+				"int64: nanoseconds",
+				int64(5400000000000),
+				nullable.NewDuration(90*time.Minute, true),
+			},
+			{
+				"string: Go",
+				"1h30m",
+				nullable.NewDuration(90*time.Minute, true),
+			},
+			{
+				"string: ISO 8601",
+				"PT1H30M",
+				nullable.NewDuration(90*time.Minute, true),
+			},
+			{
+				"string: ISO 8601 with days and fractional seconds",
+				"-P1DT0,5S",
+				nullable.NewDuration(-24*time.Hour-500*time.Millisecond, true),
+			},
+			{
+				"string: ISO 8601 with zero years",
+				"P0Y1W",
+				nullable.NewDuration(7*24*time.Hour, true),
+			},
+			{
+				"[]byte: interval",
+				[]byte("01:30:00"),
+				nullable.NewDuration(90*time.Minute, true),
+			},
+			{
+				"[]byte: interval with days and microseconds",
+				[]byte("1 day 02:03:04.5"),
+				nullable.NewDuration(26*time.Hour+3*time.Minute+4500*time.Millisecond, true),
+			},
+			{
+				"[]byte: interval with mixed signs",
+				[]byte("-1 days +02:00:00"),
+				nullable.NewDuration(-22*time.Hour, true),
+			},
+			{
+				"[]byte: negative interval",
+				[]byte("-00:00:01"),
+				nullable.NewDuration(-time.Second, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Duration
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDuration_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Duration
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewDuration(0, false),
+				[]byte(`null`),
+			},
+			{
+				"Go",
+				nullable.NewDuration(90*time.Minute, true),
+				[]byte(`"1h30m0s"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestDuration_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"boolean",
+				[]byte(`true`),
+				"invalid JSON",
+			},
+			{
+				"string: empty",
+				[]byte(`""`),
+				"invalid source",
+			},
+			{
+				"number: fractional nanoseconds",
+				[]byte(`0.5`),
+				"fractional nanoseconds",
+			},
+			{
+				"number: overflow",
+				[]byte(`9223372036854775808`),
+				"overflows",
+			},
+			{
+				"number: huge exponent",
+				[]byte(`1e999999999`),
+				"invalid number",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Duration
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Duration
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewDuration(0, false),
+			},
+			{
+				"string: Go",
+				[]byte(`"1h30m"`),
+				nullable.NewDuration(90*time.Minute, true),
+			},
+			{
+				"string: ISO 8601",
+				[]byte(`"PT1H30M"`),
+				nullable.NewDuration(90*time.Minute, true),
+			},
+			{
+				"number: nanoseconds",
+				[]byte(`5400000000000`),
+				nullable.NewDuration(90*time.Minute, true),
+			},
+			{
+				"number: nanoseconds with exponent",
+				[]byte(`5.4e12`),
+				nullable.NewDuration(90*time.Minute, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Duration
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDuration_MarshalText(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Duration
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewDuration(0, false),
+				[]byte{},
+			},
+			{
+				"non-zero",
+				nullable.NewDuration(90*time.Minute, true),
+				[]byte("1h30m0s"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := tc.in.MarshalText()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestDuration_UnmarshalText(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		var n nullable.Duration
+		err := n.UnmarshalText([]byte("invalid"))
+		require.ErrorContains(t, err, "invalid source")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Duration
+		}{
+			{
+				"empty",
+				[]byte{},
+				nullable.NewDuration(0, false),
+			},
+			{
+				"Go",
+				[]byte("1h30m"),
+				nullable.NewDuration(90*time.Minute, true),
+			},
+			{
+				"ISO 8601",
+				[]byte("PT1H30M"),
+				nullable.NewDuration(90*time.Minute, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Duration
+				err := n.UnmarshalText(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDuration_MarshalYAML(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Duration
+			want any
+		}{
+			{
+				"null",
+				nullable.NewDuration(0, false),
+				nil,
+			},
+			{
+				"non-zero",
+				nullable.NewDuration(90*time.Minute, true),
+				"1h30m0s",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.MarshalYAML()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestDuration_UnmarshalYAML(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   *yaml.Node
+			want string
+		}{
+			{
+				"sequence",
+				&yaml.Node{
+					Kind: yaml.SequenceNode,
+					Tag:  "!!seq",
+				},
+				"",
+			},
+			{
+				"string: invalid",
+				&yaml.Node{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!str",
+					Value: "invalid",
+				},
+				"invalid source",
+			},
+			{
+				"float: infinity",
+				&yaml.Node{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!float",
+					Value: ".inf",
+				},
+				"invalid number",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Duration
+				err := n.UnmarshalYAML(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   *yaml.Node
+			want nullable.Duration
+		}{
+			{
+				"null",
+				&yaml.Node{
+					Kind: yaml.ScalarNode,
+					Tag:  "!!null",
+				},
+				nullable.NewDuration(0, false),
+			},
+			{
+				"string: Go",
+				&yaml.Node{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!str",
+					Value: "1h30m",
+				},
+				nullable.NewDuration(90*time.Minute, true),
+			},
+			{
+				"string: ISO 8601",
+				&yaml.Node{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!str",
+					Value: "PT1H30M",
+				},
+				nullable.NewDuration(90*time.Minute, true),
+			},
+			{
+				"int: nanoseconds",
+				&yaml.Node{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!int",
+					Value: "1000",
+				},
+				nullable.NewDuration(time.Microsecond, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Duration
+				err := n.UnmarshalYAML(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDuration_Generate(t *testing.T) {
+	ns := generate[nullable.Duration](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Duration
+	}{
+		{
+			"null",
+			nullable.NewDuration(0, false),
+		},
+		{
+			"0",
+			nullable.NewDuration(0, true),
+		},
+		{
+			"-1ns",
+			nullable.NewDuration(-1, true),
+		},
+		{
+			"24h",
+			nullable.NewDuration(24*time.Hour, true),
+		},
+		{
+			"min",
+			nullable.NewDuration(math.MinInt64, true),
+		},
+		{
+			"max",
+			nullable.NewDuration(math.MaxInt64, true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzDuration_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Duration](f,
+		[]byte(""),
+		[]byte("1.5"),
+		[]byte("1h30m"),
+		[]byte("PT1H30M"),
+		[]byte("-P1DT0,5S"),
+		[]byte("P1M"),
+		[]byte("1 day 02:03:04.5"),
+		[]byte("-1 days +02:00:00"),
+		[]byte("1 mon 00:00:00"),
+	)
+}
+
+func FuzzDuration_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Duration](f,
+		[]byte(`true`),
+		[]byte(`""`),
+		[]byte(`0.5`),
+		[]byte(`9223372036854775808`),
+		[]byte(`1e999999999`),
+		[]byte(`null`),
+		[]byte(`"1h30m"`),
+		[]byte(`"PT1H30M"`),
+		[]byte(`5400000000000`),
+		[]byte(`5.4e12`),
+	)
+}
+
+func FuzzDuration_UnmarshalText(f *testing.F) {
+	nullabletest.FuzzText[nullable.Duration](f,
+		[]byte(""),
+		[]byte("invalid"),
+		[]byte("1h30m"),
+		[]byte("PT1H30M"),
+	)
+}
+
+func FuzzDuration_UnmarshalYAML(f *testing.F) {
+	nullabletest.FuzzYAML[nullable.Duration](f,
+		[]byte(`null`),
+		[]byte(`[]`),
+		[]byte(`invalid`),
+		[]byte(`.inf`),
+		[]byte(`1h30m`),
+		[]byte(`PT1H30M`),
+		[]byte(`1000`),
+	)
+}
@@ -0,0 +1,100 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// DurationISO8601 represents a nullable time.Duration encoded as an ISO 8601 duration string such as "PT1H30M",
+// both in JSON and in the database, for PostgreSQL interval columns.
+// It is scanned exactly as Duration, and converts to and from it.
+type DurationISO8601 Duration
+
+// NewDurationISO8601 returns a new DurationISO8601.
+func NewDurationISO8601(d time.Duration, valid bool) DurationISO8601 {
+	return DurationISO8601(NewDuration(d, valid))
+}
+
+// NullableString returns the value as a String in ISO 8601 format.
+func (n DurationISO8601) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(formatISO8601Duration(n.Duration), true)
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a string in ISO 8601 format, or nil if invalid.
+func (n DurationISO8601) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return formatISO8601Duration(n.Duration), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts the same sources as Duration.Scan.
+func (n *DurationISO8601) Scan(src any) error {
+	return (*Duration)(n).Scan(src)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in ISO 8601 format, or null if invalid.
+func (n DurationISO8601) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(formatISO8601Duration(n.Duration))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts the same JSON values as Duration.UnmarshalJSON.
+func (n *DurationISO8601) UnmarshalJSON(b []byte) error {
+	return (*Duration)(n).UnmarshalJSON(b)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns the value in ISO 8601 format, or an empty text if invalid.
+func (n DurationISO8601) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	return []byte(formatISO8601Duration(n.Duration)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts the same texts as Duration.UnmarshalText.
+func (n *DurationISO8601) UnmarshalText(b []byte) error {
+	return (*Duration)(n).UnmarshalText(b)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It returns the value as a string in ISO 8601 format, or nil if invalid.
+func (n DurationISO8601) MarshalYAML() (any, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return formatISO8601Duration(n.Duration), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It accepts the same YAML values as Duration.UnmarshalYAML.
+func (n *DurationISO8601) UnmarshalYAML(value *yaml.Node) error {
+	return (*Duration)(n).UnmarshalYAML(value)
+}
+
+// Generate implements quick.Generator.
+// It returns the same values as Duration.Generate.
+func (DurationISO8601) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(DurationISO8601(Duration{}.Generate(r, size).Interface().(Duration)))
+}
@@ -0,0 +1,200 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestDurationISO8601(t *testing.T) {
+	var n nullable.DurationISO8601
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextMarshaler)(nil), &n)
+	require.Implements(t, (*yaml.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextUnmarshaler)(nil), &n)
+	require.Implements(t, (*yaml.Unmarshaler)(nil), &n)
+}
+
+func TestDurationISO8601_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.DurationISO8601]{
+		Valid: []nullable.DurationISO8601{
+			nullable.NewDurationISO8601(0, true),
+			nullable.NewDurationISO8601(90*time.Minute, true),
+			nullable.NewDurationISO8601(-1500*time.Millisecond, true),
+			nullable.NewDurationISO8601(math.MinInt64, true),
+			nullable.NewDurationISO8601(math.MaxInt64, true),
+		},
+		InvalidScan: []any{
+			"",
+			[]byte("P1Y"),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`""`),
+			[]byte(`"P1M"`),
+		},
+	})
+}
+
+func TestDurationISO8601_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.DurationISO8601
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewDurationISO8601(0, false),
+				nullable.NewString("", false),
+			},
+			{
+				"non-zero",
+				nullable.NewDurationISO8601(90*time.Minute, true),
+				nullable.NewString("PT1H30M", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				s := tc.in.NullableString()
+				require.Equal(t, tc.want, s)
+			})
+		}
+	})
+}
+
+func TestDurationISO8601_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.DurationISO8601
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewDurationISO8601(0, false),
+				nil,
+			},
+			{
+				"zero",
+				nullable.NewDurationISO8601(0, true),
+				"PT0S",
+			},
+			{
+				"non-zero",
+				nullable.NewDurationISO8601(90*time.Minute, true),
+				"PT1H30M",
+			},
+			{
+				"negative fractional",
+				nullable.NewDurationISO8601(-1500*time.Millisecond, true),
+				"-PT1.5S",
+			},
+			{
+				"min",
+				nullable.NewDurationISO8601(math.MinInt64, true),
+				"-PT2562047H47M16.854775808S",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestDurationISO8601_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.DurationISO8601
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewDurationISO8601(0, false),
+				[]byte(`null`),
+			},
+			{
+				"non-zero",
+				nullable.NewDurationISO8601(90*time.Minute, true),
+				[]byte(`"PT1H30M"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestDurationISO8601_MarshalYAML(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.DurationISO8601
+			want any
+		}{
+			{
+				"null",
+				nullable.NewDurationISO8601(0, false),
+				nil,
+			},
+			{
+				"non-zero",
+				nullable.NewDurationISO8601(90*time.Minute, true),
+				"PT1H30M",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.MarshalYAML()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func FuzzDurationISO8601_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.DurationISO8601](f,
+		[]byte(""),
+		[]byte("PT1H30M"),
+		[]byte("-P1DT0,5S"),
+		[]byte("1 day 02:03:04.5"),
+	)
+}
+
+func FuzzDurationISO8601_UnmarshalYAML(f *testing.F) {
+	nullabletest.FuzzYAML[nullable.DurationISO8601](f,
+		[]byte(`null`),
+		[]byte(`[]`),
+		[]byte(`invalid`),
+		[]byte(`PT1H30M`),
+		[]byte(`-P1DT0,5S`),
+		[]byte(`1h30m`),
+	)
+}
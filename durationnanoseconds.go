@@ -0,0 +1,95 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// DurationNanoseconds represents a nullable time.Duration encoded in JSON as an integer number of nanoseconds.
+// It is stored and scanned exactly as Duration, and converts to and from it.
+type DurationNanoseconds Duration
+
+// NewDurationNanoseconds returns a new DurationNanoseconds.
+func NewDurationNanoseconds(d time.Duration, valid bool) DurationNanoseconds {
+	return DurationNanoseconds(NewDuration(d, valid))
+}
+
+// NullableString returns the value as a String of nanoseconds.
+func (n DurationNanoseconds) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(strconv.FormatInt(int64(n.Duration), 10), true)
+}
+
+// Value implements driver.Valuer.
+// It returns the same driver.Value as Duration.Value.
+func (n DurationNanoseconds) Value() (driver.Value, error) {
+	return Duration(n).Value()
+}
+
+// Scan implements sql.Scanner.
+// It accepts the same sources as Duration.Scan.
+func (n *DurationNanoseconds) Scan(src any) error {
+	return (*Duration)(n).Scan(src)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON number of nanoseconds, or null if invalid.
+func (n DurationNanoseconds) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return []byte(strconv.FormatInt(int64(n.Duration), 10)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts the same JSON values as Duration.UnmarshalJSON.
+func (n *DurationNanoseconds) UnmarshalJSON(b []byte) error {
+	return (*Duration)(n).UnmarshalJSON(b)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns the value as a number of nanoseconds, or an empty text if invalid.
+func (n DurationNanoseconds) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	return []byte(strconv.FormatInt(int64(n.Duration), 10)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts a number of nanoseconds, the texts Duration.UnmarshalText accepts, or an empty text as null.
+func (n *DurationNanoseconds) UnmarshalText(b []byte) error {
+	return (*Duration)(n).unmarshalNumberText(b, time.Nanosecond)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It returns the value as an int64 of nanoseconds, or nil if invalid.
+func (n DurationNanoseconds) MarshalYAML() (any, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return int64(n.Duration), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It accepts the same YAML values as Duration.UnmarshalYAML.
+func (n *DurationNanoseconds) UnmarshalYAML(value *yaml.Node) error {
+	return (*Duration)(n).UnmarshalYAML(value)
+}
+
+// Generate implements quick.Generator.
+// It returns the same values as Duration.Generate.
+func (DurationNanoseconds) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(DurationNanoseconds(Duration{}.Generate(r, size).Interface().(Duration)))
+}
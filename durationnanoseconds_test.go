@@ -0,0 +1,212 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestDurationNanoseconds(t *testing.T) {
+	var n nullable.DurationNanoseconds
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextMarshaler)(nil), &n)
+	require.Implements(t, (*yaml.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextUnmarshaler)(nil), &n)
+	require.Implements(t, (*yaml.Unmarshaler)(nil), &n)
+}
+
+func TestDurationNanoseconds_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.DurationNanoseconds]{
+		Valid: []nullable.DurationNanoseconds{
+			nullable.NewDurationNanoseconds(0, true),
+			nullable.NewDurationNanoseconds(90*time.Minute, true),
+			nullable.NewDurationNanoseconds(-time.Nanosecond, true),
+			nullable.NewDurationNanoseconds(math.MinInt64, true),
+			nullable.NewDurationNanoseconds(math.MaxInt64, true),
+		},
+		InvalidScan: []any{
+			"",
+			[]byte("P1Y"),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`0.5`),
+			[]byte(`9223372036854775808`),
+		},
+	})
+}
+
+func TestDurationNanoseconds_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.DurationNanoseconds
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewDurationNanoseconds(0, false),
+				nullable.NewString("", false),
+			},
+			{
+				"non-zero",
+				nullable.NewDurationNanoseconds(90*time.Minute, true),
+				nullable.NewString("5400000000000", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				s := tc.in.NullableString()
+				require.Equal(t, tc.want, s)
+			})
+		}
+	})
+}
+
+func TestDurationNanoseconds_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.DurationNanoseconds
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewDurationNanoseconds(0, false),
+				[]byte(`null`),
+			},
+			{
+				"positive",
+				nullable.NewDurationNanoseconds(90*time.Minute, true),
+				[]byte(`5400000000000`),
+			},
+			{
+				"min",
+				nullable.NewDurationNanoseconds(math.MinInt64, true),
+				[]byte(`-9223372036854775808`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestDurationNanoseconds_UnmarshalText(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		var n nullable.DurationNanoseconds
+		err := n.UnmarshalText([]byte("0.5"))
+		require.ErrorContains(t, err, "fractional nanoseconds")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.DurationNanoseconds
+		}{
+			{
+				"empty",
+				[]byte{},
+				nullable.NewDurationNanoseconds(0, false),
+			},
+			{
+				"number",
+				[]byte("5400000000000"),
+				nullable.NewDurationNanoseconds(90*time.Minute, true),
+			},
+			{
+				"Go",
+				[]byte("1h30m"),
+				nullable.NewDurationNanoseconds(90*time.Minute, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.DurationNanoseconds
+				err := n.UnmarshalText(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDurationNanoseconds_MarshalYAML(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.DurationNanoseconds
+			want any
+		}{
+			{
+				"null",
+				nullable.NewDurationNanoseconds(0, false),
+				nil,
+			},
+			{
+				"non-zero",
+				nullable.NewDurationNanoseconds(time.Microsecond, true),
+				int64(1000),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.MarshalYAML()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func FuzzDurationNanoseconds_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.DurationNanoseconds](f,
+		[]byte(`null`),
+		[]byte(`5400000000000`),
+		[]byte(`5.4e12`),
+		[]byte(`0.5`),
+		[]byte(`"1h30m"`),
+	)
+}
+
+func FuzzDurationNanoseconds_UnmarshalText(f *testing.F) {
+	nullabletest.FuzzText[nullable.DurationNanoseconds](f,
+		[]byte(""),
+		[]byte("5400000000000"),
+		[]byte("1h30m"),
+		[]byte("PT1H30M"),
+	)
+}
+
+func FuzzDurationNanoseconds_UnmarshalYAML(f *testing.F) {
+	nullabletest.FuzzYAML[nullable.DurationNanoseconds](f,
+		[]byte(`null`),
+		[]byte(`[]`),
+		[]byte(`invalid`),
+		[]byte(`5400000000000`),
+		[]byte(`1h30m`),
+		[]byte(`PT1H30M`),
+	)
+}
@@ -0,0 +1,174 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// DurationSeconds represents a nullable time.Duration of whole seconds,
+// encoded in JSON as an integer number of seconds and stored as an int64 of seconds.
+// Decoding rejects durations with a fractional second.
+type DurationSeconds Duration
+
+// NewDurationSeconds returns a new DurationSeconds.
+func NewDurationSeconds(d time.Duration, valid bool) DurationSeconds {
+	return DurationSeconds(NewDuration(d, valid))
+}
+
+// NullableString returns the value as a String of seconds, fractional if the value is not a whole number of seconds.
+func (n DurationSeconds) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(formatSeconds(n.Duration), true)
+}
+
+// Value implements driver.Valuer.
+// It returns the value as an int64 of seconds, or nil if invalid.
+// It returns an error if the value is not a whole number of seconds.
+func (n DurationSeconds) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.seconds()
+}
+
+// Scan implements sql.Scanner.
+// It accepts:
+//   - int64 (seconds)
+//   - string (Go duration, ISO 8601 duration or PostgreSQL interval)
+//   - []byte (Go duration, ISO 8601 duration or PostgreSQL interval)
+//   - nil
+func (n *DurationSeconds) Scan(src any) error {
+	var d Duration
+	if err := d.scan(src, time.Second); err != nil {
+		return err
+	}
+
+	return n.set(d)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON number of seconds, or null if invalid.
+// It returns an error if the value is not a whole number of seconds.
+func (n DurationSeconds) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	sec, err := n.seconds()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strconv.FormatInt(sec, 10)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string (Go duration, ISO 8601 duration or PostgreSQL interval),
+// a JSON number of seconds, or null.
+func (n *DurationSeconds) UnmarshalJSON(b []byte) error {
+	var d Duration
+	if err := d.unmarshalJSON(b, time.Second); err != nil {
+		return err
+	}
+
+	return n.set(d)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns the value as a number of seconds, or an empty text if invalid.
+// It returns an error if the value is not a whole number of seconds.
+func (n DurationSeconds) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	sec, err := n.seconds()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strconv.FormatInt(sec, 10)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts a number of seconds, a Go duration, an ISO 8601 duration or a PostgreSQL interval,
+// or an empty text as null.
+func (n *DurationSeconds) UnmarshalText(b []byte) error {
+	var d Duration
+	if err := d.unmarshalNumberText(b, time.Second); err != nil {
+		return err
+	}
+
+	return n.set(d)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It returns the value as an int64 of seconds, or nil if invalid.
+// It returns an error if the value is not a whole number of seconds.
+func (n DurationSeconds) MarshalYAML() (any, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.seconds()
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It accepts a YAML string (Go duration, ISO 8601 duration or PostgreSQL interval),
+// a YAML number of seconds, or null.
+func (n *DurationSeconds) UnmarshalYAML(value *yaml.Node) error {
+	var d Duration
+	if err := d.unmarshalYAML(value, time.Second); err != nil {
+		return err
+	}
+
+	return n.set(d)
+}
+
+// maxDurationSeconds is the largest whole number of seconds a time.Duration can hold.
+const maxDurationSeconds = math.MaxInt64 / int64(time.Second)
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (0, ±1s, 1h30m, 24h, or the minimum or maximum whole number of seconds),
+// or a random whole number of seconds.
+func (DurationSeconds) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewDurationSeconds(0, false))
+	}
+
+	if sec, ok := generateEdge[int64](r, 0, 1, -1, 90*60, 24*60*60, -maxDurationSeconds, maxDurationSeconds); ok {
+		return reflect.ValueOf(NewDurationSeconds(time.Duration(sec)*time.Second, true))
+	}
+
+	return reflect.ValueOf(NewDurationSeconds(time.Duration(r.Int63n(2*maxDurationSeconds+1)-maxDurationSeconds)*time.Second, true))
+}
+
+func (n DurationSeconds) seconds() (int64, error) {
+	if n.Duration%time.Second != 0 {
+		return 0, fmt.Errorf("%s is not a whole number of seconds", n.Duration)
+	}
+
+	return int64(n.Duration / time.Second), nil
+}
+
+// set sets the value to d, rejecting durations with a fractional second.
+func (n *DurationSeconds) set(d Duration) error {
+	if d.Valid && d.Duration%time.Second != 0 {
+		return fmt.Errorf("invalid source: %s is not a whole number of seconds", d.Duration)
+	}
+
+	*n = DurationSeconds(d)
+
+	return nil
+}
@@ -0,0 +1,520 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestDurationSeconds(t *testing.T) {
+	var n nullable.DurationSeconds
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextMarshaler)(nil), &n)
+	require.Implements(t, (*yaml.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextUnmarshaler)(nil), &n)
+	require.Implements(t, (*yaml.Unmarshaler)(nil), &n)
+}
+
+func TestDurationSeconds_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.DurationSeconds]{
+		Valid: []nullable.DurationSeconds{
+			nullable.NewDurationSeconds(0, true),
+			nullable.NewDurationSeconds(90*time.Minute, true),
+			nullable.NewDurationSeconds(-time.Second, true),
+			nullable.NewDurationSeconds(math.MaxInt64/time.Second*time.Second, true),
+		},
+		InvalidScan: []any{
+			int64(math.MaxInt64),
+			"1.5s",
+			[]byte("P1Y"),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`1.5`),
+			[]byte(`"1.5s"`),
+			[]byte(`9223372037`),
+		},
+	})
+}
+
+func TestDurationSeconds_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.DurationSeconds
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewDurationSeconds(0, false),
+				nullable.NewString("", false),
+			},
+			{
+				"whole",
+				nullable.NewDurationSeconds(90*time.Minute, true),
+				nullable.NewString("5400", true),
+			},
+			{
+				"fractional",
+				nullable.NewDurationSeconds(-1500*time.Millisecond, true),
+				nullable.NewString("-1.5", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				s := tc.in.NullableString()
+				require.Equal(t, tc.want, s)
+			})
+		}
+	})
+}
+
+func TestDurationSeconds_Value(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := nullable.NewDurationSeconds(1500*time.Millisecond, true).Value()
+		require.ErrorContains(t, err, "not a whole number of seconds")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.DurationSeconds
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewDurationSeconds(0, false),
+				nil,
+			},
+			{
+				"non-zero",
+				nullable.NewDurationSeconds(90*time.Minute, true),
+				int64(5400),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestDurationSeconds_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"float64",
+				float64(0),
+				"unsupported source type: float64",
+			},
+			{
+				"int64: overflow",
+				int64(math.MaxInt64),
+				"invalid source: 9223372036854775807 seconds overflows time.Duration",
+			},
+			{
+				"string: fractional",
+				"1.5s",
+				"invalid source: 1.5s is not a whole number of seconds",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.DurationSeconds
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.DurationSeconds
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewDurationSeconds(0, false),
+			},
+			{
+				"int64",
+				int64(5400),
+				nullable.NewDurationSeconds(90*time.Minute, true),
+			},
+			{
+				"string: ISO 8601",
+				"PT1H30M",
+				nullable.NewDurationSeconds(90*time.Minute, true),
+			},
+			{
+				"[]byte: interval",
+				[]byte("01:30:00"),
+				nullable.NewDurationSeconds(90*time.Minute, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.DurationSeconds
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDurationSeconds_MarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := nullable.NewDurationSeconds(1500*time.Millisecond, true).MarshalJSON()
+		require.ErrorContains(t, err, "not a whole number of seconds")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.DurationSeconds
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewDurationSeconds(0, false),
+				[]byte(`null`),
+			},
+			{
+				"positive",
+				nullable.NewDurationSeconds(90*time.Minute, true),
+				[]byte(`5400`),
+			},
+			{
+				"negative",
+				nullable.NewDurationSeconds(-time.Second, true),
+				[]byte(`-1`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestDurationSeconds_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"boolean",
+				[]byte(`true`),
+				"invalid JSON",
+			},
+			{
+				"number: fractional",
+				[]byte(`1.5`),
+				"invalid source: 1.5s is not a whole number of seconds",
+			},
+			{
+				"number: overflow",
+				[]byte(`9223372037`),
+				"overflows",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.DurationSeconds
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.DurationSeconds
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewDurationSeconds(0, false),
+			},
+			{
+				"number",
+				[]byte(`5400`),
+				nullable.NewDurationSeconds(90*time.Minute, true),
+			},
+			{
+				"number: with exponent",
+				[]byte(`5.4e3`),
+				nullable.NewDurationSeconds(90*time.Minute, true),
+			},
+			{
+				"string: Go",
+				[]byte(`"1h30m"`),
+				nullable.NewDurationSeconds(90*time.Minute, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.DurationSeconds
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDurationSeconds_MarshalText(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.DurationSeconds
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewDurationSeconds(0, false),
+				[]byte{},
+			},
+			{
+				"non-zero",
+				nullable.NewDurationSeconds(90*time.Minute, true),
+				[]byte("5400"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := tc.in.MarshalText()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestDurationSeconds_UnmarshalText(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"invalid",
+				[]byte("invalid"),
+				"invalid source",
+			},
+			{
+				"fractional",
+				[]byte("0.5"),
+				"not a whole number of seconds",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.DurationSeconds
+				err := n.UnmarshalText(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.DurationSeconds
+		}{
+			{
+				"empty",
+				[]byte{},
+				nullable.NewDurationSeconds(0, false),
+			},
+			{
+				"number",
+				[]byte("5400"),
+				nullable.NewDurationSeconds(90*time.Minute, true),
+			},
+			{
+				"Go",
+				[]byte("1h30m"),
+				nullable.NewDurationSeconds(90*time.Minute, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.DurationSeconds
+				err := n.UnmarshalText(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDurationSeconds_MarshalYAML(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.DurationSeconds
+			want any
+		}{
+			{
+				"null",
+				nullable.NewDurationSeconds(0, false),
+				nil,
+			},
+			{
+				"non-zero",
+				nullable.NewDurationSeconds(90*time.Minute, true),
+				int64(5400),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.MarshalYAML()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestDurationSeconds_UnmarshalYAML(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.DurationSeconds
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewDurationSeconds(0, false),
+			},
+			{
+				"int",
+				[]byte(`30`),
+				nullable.NewDurationSeconds(30*time.Second, true),
+			},
+			{
+				"string",
+				[]byte(`1h30m`),
+				nullable.NewDurationSeconds(90*time.Minute, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.DurationSeconds
+				err := yaml.Unmarshal(tc.in, &n)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestDurationSeconds_Generate(t *testing.T) {
+	ns := generate[nullable.DurationSeconds](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.DurationSeconds
+	}{
+		{
+			"null",
+			nullable.NewDurationSeconds(0, false),
+		},
+		{
+			"0",
+			nullable.NewDurationSeconds(0, true),
+		},
+		{
+			"-1s",
+			nullable.NewDurationSeconds(-time.Second, true),
+		},
+		{
+			"max",
+			nullable.NewDurationSeconds(math.MaxInt64/time.Second*time.Second, true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzDurationSeconds_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.DurationSeconds](f,
+		[]byte(""),
+		[]byte("1h30m"),
+		[]byte("1.5s"),
+		[]byte("PT1H30M"),
+		[]byte("1 day 02:03:04"),
+	)
+}
+
+func FuzzDurationSeconds_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.DurationSeconds](f,
+		[]byte(`null`),
+		[]byte(`5400`),
+		[]byte(`1.5`),
+		[]byte(`5.4e3`),
+		[]byte(`"1h30m"`),
+	)
+}
+
+func FuzzDurationSeconds_UnmarshalYAML(f *testing.F) {
+	nullabletest.FuzzYAML[nullable.DurationSeconds](f,
+		[]byte(`null`),
+		[]byte(`[]`),
+		[]byte(`invalid`),
+		[]byte(`5400`),
+		[]byte(`1.5`),
+		[]byte(`1h30m`),
+		[]byte(`PT1H30M`),
+	)
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"database/sql/driver"
 	"encoding/json"
+	"math/rand"
+	"reflect"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 )
@@ -86,3 +88,20 @@ func (n *EthAddress) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (the zero address or 0xff...ff), or a random address.
+func (EthAddress) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewEthAddress(ethcommon.Address{}, false))
+	}
+
+	if b, ok := generateEdge(r, []byte{}, bytes.Repeat([]byte{0xff}, ethcommon.AddressLength)); ok {
+		return reflect.ValueOf(NewEthAddress(ethcommon.BytesToAddress(b), true))
+	}
+
+	var a ethcommon.Address
+	r.Read(a[:])
+
+	return reflect.ValueOf(NewEthAddress(a, true))
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
 )
 
 func TestEthAddress(t *testing.T) {
@@ -21,6 +23,24 @@ func TestEthAddress(t *testing.T) {
 	require.Implements(t, (*json.Unmarshaler)(nil), &n)
 }
 
+func TestEthAddress_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.EthAddress]{
+		Valid: []nullable.EthAddress{
+			nullable.NewEthAddress(ethcommon.Address{}, true),
+			nullable.NewEthAddress(ethcommon.HexToAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"), true),
+		},
+		InvalidScan: []any{
+			"0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+			[]byte{},
+			[]byte{0x00},
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`""`),
+			[]byte(`"invalid"`),
+		},
+	})
+}
+
 func TestEthAddress_NullableString(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -233,3 +253,49 @@ func TestEthAddress_UnmarshalJSON(t *testing.T) {
 		}
 	})
 }
+
+func TestEthAddress_Generate(t *testing.T) {
+	ns := generate[nullable.EthAddress](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.EthAddress
+	}{
+		{
+			"null",
+			nullable.NewEthAddress(ethcommon.Address{}, false),
+		},
+		{
+			"zero",
+			nullable.NewEthAddress(ethcommon.Address{}, true),
+		},
+		{
+			"max",
+			nullable.NewEthAddress(ethcommon.HexToAddress("0x"+strings.Repeat("ff", 20)), true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzEthAddress_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.EthAddress](f,
+		[]byte{},
+		[]byte{0x00},
+		ethhexutil.MustDecode("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"),
+	)
+}
+
+func FuzzEthAddress_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.EthAddress](f,
+		[]byte{},
+		[]byte(`""`),
+		[]byte(`"0x00"`),
+		[]byte(`null`),
+		[]byte(`"0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"`),
+	)
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"database/sql/driver"
 	"encoding/json"
+	"math/rand"
+	"reflect"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 )
@@ -86,3 +88,20 @@ func (n *EthHash) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (the zero hash or 0xff...ff), or a random hash.
+func (EthHash) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewEthHash(ethcommon.Hash{}, false))
+	}
+
+	if b, ok := generateEdge(r, []byte{}, bytes.Repeat([]byte{0xff}, ethcommon.HashLength)); ok {
+		return reflect.ValueOf(NewEthHash(ethcommon.BytesToHash(b), true))
+	}
+
+	var h ethcommon.Hash
+	r.Read(h[:])
+
+	return reflect.ValueOf(NewEthHash(h, true))
+}
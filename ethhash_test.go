@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
 )
 
 func TestEthHash(t *testing.T) {
@@ -21,6 +23,24 @@ func TestEthHash(t *testing.T) {
 	require.Implements(t, (*json.Unmarshaler)(nil), &n)
 }
 
+func TestEthHash_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.EthHash]{
+		Valid: []nullable.EthHash{
+			nullable.NewEthHash(ethcommon.Hash{}, true),
+			nullable.NewEthHash(ethcommon.HexToHash("0x000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"), true),
+		},
+		InvalidScan: []any{
+			"0x000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f",
+			[]byte{},
+			[]byte{0x00},
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`""`),
+			[]byte(`"invalid"`),
+		},
+	})
+}
+
 func TestEthHash_NullableString(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -233,3 +253,49 @@ func TestEthHash_UnmarshalJSON(t *testing.T) {
 		}
 	})
 }
+
+func TestEthHash_Generate(t *testing.T) {
+	ns := generate[nullable.EthHash](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.EthHash
+	}{
+		{
+			"null",
+			nullable.NewEthHash(ethcommon.Hash{}, false),
+		},
+		{
+			"zero",
+			nullable.NewEthHash(ethcommon.Hash{}, true),
+		},
+		{
+			"max",
+			nullable.NewEthHash(ethcommon.HexToHash("0x"+strings.Repeat("ff", 32)), true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzEthHash_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.EthHash](f,
+		[]byte{},
+		[]byte{0x00},
+		ethhexutil.MustDecode("0x000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"),
+	)
+}
+
+func FuzzEthHash_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.EthHash](f,
+		[]byte{},
+		[]byte(`""`),
+		[]byte(`"0x00"`),
+		[]byte(`null`),
+		[]byte(`"0x000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"`),
+	)
+}
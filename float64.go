@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"math"
+	"math/rand"
+	"reflect"
 )
 
 // Float64 represents a nullable float64 wrapping sql.NullFloat64.
@@ -68,3 +71,26 @@ func (n *Float64) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (±0, ±1, the smallest and largest finite magnitudes,
+// ±Inf or NaN), or a random float64.
+// Note that ±Inf and NaN cannot be marshaled to JSON.
+func (Float64) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewFloat64(0, false))
+	}
+
+	if f, ok := generateEdge(r,
+		0, math.Copysign(0, -1),
+		1, -1,
+		math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64,
+		math.MaxFloat64, -math.MaxFloat64,
+		math.Inf(1), math.Inf(-1),
+		math.NaN(),
+	); ok {
+		return reflect.ValueOf(NewFloat64(f, true))
+	}
+
+	return reflect.ValueOf(NewFloat64(r.NormFloat64()*math.Pow(10, float64(r.Intn(21)-10)), true))
+}
@@ -5,11 +5,13 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"math"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
 )
 
 func TestFloat64(t *testing.T) {
@@ -20,6 +22,24 @@ func TestFloat64(t *testing.T) {
 	require.Implements(t, (*json.Unmarshaler)(nil), &n)
 }
 
+func TestFloat64_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Float64]{
+		Valid: []nullable.Float64{
+			nullable.NewFloat64(0, true),
+			nullable.NewFloat64(-math.MaxFloat64, true),
+			nullable.NewFloat64(math.MaxFloat64, true),
+			nullable.NewFloat64(math.SmallestNonzeroFloat64, true),
+		},
+		InvalidScan: []any{
+			"invalid",
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`"0"`),
+		},
+	})
+}
+
 func TestNewFloat64FromFloat64Ptr(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -253,3 +273,82 @@ func TestFloat64_UnmarshalJSON(t *testing.T) {
 		}
 	})
 }
+
+func TestFloat64_Generate(t *testing.T) {
+	ns := generate[nullable.Float64](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Float64
+	}{
+		{
+			"null",
+			nullable.NewFloat64(0, false),
+		},
+		{
+			"0",
+			nullable.NewFloat64(0, true),
+		},
+		{
+			"-0",
+			nullable.NewFloat64(math.Copysign(0, -1), true),
+		},
+		{
+			"smallest",
+			nullable.NewFloat64(math.SmallestNonzeroFloat64, true),
+		},
+		{
+			"max",
+			nullable.NewFloat64(math.MaxFloat64, true),
+		},
+		{
+			"-max",
+			nullable.NewFloat64(-math.MaxFloat64, true),
+		},
+		{
+			"+Inf",
+			nullable.NewFloat64(math.Inf(1), true),
+		},
+		{
+			"-Inf",
+			nullable.NewFloat64(math.Inf(-1), true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+
+	t.Run("NaN", func(t *testing.T) {
+		require.True(t, slices.ContainsFunc(ns, func(n nullable.Float64) bool {
+			return n.Valid && math.IsNaN(n.Float64)
+		}))
+	})
+}
+
+func FuzzFloat64_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Float64](f,
+		[]byte("0"),
+		[]byte("5e-324"),
+		[]byte("1.7976931348623157e+308"),
+		[]byte("1e+309"),
+		[]byte("NaN"),
+		[]byte("invalid"),
+	)
+}
+
+func FuzzFloat64_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Float64](f,
+		[]byte(`true`),
+		[]byte(`-1e+309`),
+		[]byte(`1e+309`),
+		[]byte(`"0"`),
+		[]byte(`null`),
+		[]byte(`0`),
+		[]byte(`5e-324`),
+		[]byte(`-1.7976931348623157e+308`),
+		[]byte(`1.7976931348623157e+308`),
+	)
+}
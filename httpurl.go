@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"database/sql/driver"
 	"encoding/json"
+	"math/rand"
+	"reflect"
+	"strings"
 
 	"github.com/m0t0k1ch1-go/sqlutil/v3"
 )
@@ -86,3 +89,38 @@ func (n *HTTPURL) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (a bare host, an explicit port, or a path with a query
+// and a fragment), or a random http or https URL.
+func (HTTPURL) Generate(r *rand.Rand, size int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewHTTPURL(sqlutil.HTTPURL{}, false))
+	}
+
+	if s, ok := generateEdge(r,
+		"http://localhost",
+		"https://example.com:8443",
+		"https://example.com/a%20b?q=1&r=%E3%81%AC#f",
+	); ok {
+		return reflect.ValueOf(NewHTTPURL(sqlutil.MustNewHTTPURLFromString(s), true))
+	}
+
+	const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+	var b strings.Builder
+	b.WriteString([]string{"http", "https"}[r.Intn(2)])
+	b.WriteString("://")
+	for range 1 + r.Intn(max(size, 1)) {
+		b.WriteByte(letters[r.Intn(len(letters))])
+	}
+	b.WriteString(".com")
+	for range r.Intn(4) {
+		b.WriteByte('/')
+		for range r.Intn(8) {
+			b.WriteByte(letters[r.Intn(len(letters))])
+		}
+	}
+
+	return reflect.ValueOf(NewHTTPURL(sqlutil.MustNewHTTPURLFromString(b.String()), true))
+}
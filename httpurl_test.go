@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
 )
 
 func TestHTTPURL(t *testing.T) {
@@ -20,6 +21,25 @@ func TestHTTPURL(t *testing.T) {
 	require.Implements(t, (*json.Unmarshaler)(nil), &n)
 }
 
+func TestHTTPURL_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.HTTPURL]{
+		Valid: []nullable.HTTPURL{
+			nullable.NewHTTPURL(sqlutil.MustNewHTTPURLFromString("http://m0t0k1ch1.com"), true),
+			nullable.NewHTTPURL(sqlutil.MustNewHTTPURLFromString("https://m0t0k1ch1.com"), true),
+		},
+		InvalidScan: []any{
+			true,
+			"",
+			"ftp://m0t0k1ch1.com",
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`""`),
+			[]byte(`"ftp://m0t0k1ch1.com"`),
+		},
+	})
+}
+
 func TestHTTPURL_NullableString(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -277,3 +297,58 @@ func TestHTTPURL_UnmarshalJSON(t *testing.T) {
 		}
 	})
 }
+
+func TestHTTPURL_Generate(t *testing.T) {
+	var ns []nullable.String
+	for _, n := range generate[nullable.HTTPURL](t, 1000) {
+		ns = append(ns, n.NullableString())
+	}
+
+	tcs := []struct {
+		name string
+		want nullable.String
+	}{
+		{
+			"null",
+			nullable.NewString("", false),
+		},
+		{
+			"localhost",
+			nullable.NewString("http://localhost", true),
+		},
+		{
+			"port",
+			nullable.NewString("https://example.com:8443", true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzHTTPURL_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.HTTPURL](f,
+		"",
+		"m0t0k1ch1.com",
+		"://m0t0k1ch1.com",
+		"ftp://m0t0k1ch1.com",
+		"http://m0t0k1ch1.com",
+		"https://m0t0k1ch1.com",
+	)
+}
+
+func FuzzHTTPURL_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.HTTPURL](f,
+		[]byte(`true`),
+		[]byte(`""`),
+		[]byte(`"m0t0k1ch1.com"`),
+		[]byte(`"://m0t0k1ch1.com"`),
+		[]byte(`"ftp://m0t0k1ch1.com"`),
+		[]byte(`null`),
+		[]byte(`"http://m0t0k1ch1.com"`),
+		[]byte(`"https://m0t0k1ch1.com"`),
+	)
+}
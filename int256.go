@@ -0,0 +1,294 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+
+	"github.com/m0t0k1ch1-go/bigutil/v3"
+)
+
+var (
+	// minInt256 is -2^255, the minimum of a two's complement 256-bit integer.
+	minInt256 = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+
+	// maxInt256 is 2^255-1, the maximum of a two's complement 256-bit integer.
+	maxInt256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+
+	errInt256Range = errors.New("out of int256 range")
+)
+
+// Int256 represents a nullable signed 256-bit integer, such as a Solidity int256.
+// A valid Int256 is between -2^255 and 2^255-1; the zero Int256 with Valid set is 0.
+//
+// It holds its own copy of the integer, which it never modifies, as BigInt does.
+type Int256 struct {
+	x     *big.Int
+	Valid bool
+}
+
+// MustNewInt256 is NewInt256Checked, except that it panics if valid and x is out of the int256 range.
+// It is meant for constants and other x known to be in range.
+func MustNewInt256(x *big.Int, valid bool) Int256 {
+	n, err := NewInt256Checked(x, valid)
+	if err != nil {
+		panic(err)
+	}
+
+	return n
+}
+
+// NewInt256Checked returns a new Int256 holding a copy of x; a nil x is taken as 0.
+// It returns an error if valid and x is out of the int256 range.
+func NewInt256Checked(x *big.Int, valid bool) (Int256, error) {
+	if !valid {
+		return Int256{}, nil
+	}
+
+	if x == nil {
+		return Int256{x: new(big.Int), Valid: true}, nil
+	}
+
+	if x.Cmp(minInt256) < 0 || x.Cmp(maxInt256) > 0 {
+		return Int256{}, fmt.Errorf("%s is %w", x, errInt256Range)
+	}
+
+	return Int256{x: new(big.Int).Set(x), Valid: true}, nil
+}
+
+// NewInt256FromInt64 returns a new Int256 from an int64.
+func NewInt256FromInt64(i int64, valid bool) Int256 {
+	if !valid {
+		return Int256{}
+	}
+
+	return Int256{x: big.NewInt(i), Valid: true}
+}
+
+// NewInt256FromUint256 returns a new Int256 from a Uint256, or null if u is invalid.
+// It returns an error if u is 2^255 or more.
+func NewInt256FromUint256(u Uint256) (Int256, error) {
+	if !u.Valid {
+		return Int256{}, nil
+	}
+
+	return NewInt256Checked(u.Uint256.BigInt(), true)
+}
+
+// BigInt returns a copy of the value as a *big.Int, or nil if invalid.
+func (n Int256) BigInt() *big.Int {
+	if !n.Valid {
+		return nil
+	}
+
+	return new(big.Int).Set(n.bigInt())
+}
+
+// Uint256 returns the value as a Uint256, or null if invalid.
+// It returns an error if the value is negative.
+func (n Int256) Uint256() (Uint256, error) {
+	if !n.Valid {
+		return NewUint256(bigutil.Uint256{}, false), nil
+	}
+
+	x256, err := bigutil.NewUint256(n.bigInt())
+	if err != nil {
+		return Uint256{}, err
+	}
+
+	return NewUint256(x256, true), nil
+}
+
+// NullableString returns the value as a String in 0x-prefixed hex, such as "-0x1f".
+func (n Int256) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(n.format(), true)
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a []byte in the shortest big-endian two's complement form,
+// as Uint256 does in unsigned form, or nil if invalid.
+func (n Int256) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return twosComplementBytes(n.bigInt()), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts one of the following:
+//   - int64
+//   - string (decimal integer)
+//   - []byte (1 to 32 bytes in big-endian two's complement form)
+//   - nil
+//
+// Use Int256Numeric for NUMERIC(78, 0) columns, which return decimal integers as []byte.
+func (n *Int256) Scan(src any) error {
+	if src == nil {
+		*n = Int256{}
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case int64:
+		*n = NewInt256FromInt64(v, true)
+
+		return nil
+
+	case string:
+		return n.parse(v, 10)
+
+	case []byte:
+		if len(v) == 0 {
+			return errors.New("invalid source: empty []byte")
+		}
+
+		if len(v) > 32 {
+			return fmt.Errorf("invalid source: %d bytes exceeds 256 bits", len(v))
+		}
+
+		x := new(big.Int).SetBytes(v)
+		if v[0]&0x80 != 0 {
+			x.Sub(x, new(big.Int).Lsh(big.NewInt(1), uint(8*len(v))))
+		}
+
+		*n = Int256{x: x, Valid: true}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in 0x-prefixed hex, such as "-0x1f", or null if invalid.
+func (n Int256) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.format())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts one of the following:
+//   - JSON number (integer)
+//   - JSON string (decimal integer, such as "-31")
+//   - JSON string (0x-prefixed hex, such as "-0x1f")
+//   - null
+func (n *Int256) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = Int256{}
+
+		return nil
+	}
+
+	if !json.Valid(b) {
+		return fmt.Errorf("invalid JSON: %s", b)
+	}
+
+	if b[0] != '"' {
+		return n.parse(string(b), 10)
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	neg := strings.HasPrefix(s, "-")
+	if h, ok := strings.CutPrefix(strings.TrimPrefix(s, "-"), "0x"); ok {
+		if neg {
+			h = "-" + h
+		}
+
+		return n.parse(h, 16)
+	}
+
+	return n.parse(s, 10)
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (0, ±1, -2^255 or 2^255-1), or a random int256.
+func (Int256) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(Int256{})
+	}
+
+	if x, ok := generateEdge(r, new(big.Int), big.NewInt(1), big.NewInt(-1), minInt256, maxInt256); ok {
+		return reflect.ValueOf(MustNewInt256(x, true))
+	}
+
+	b := make([]byte, r.Intn(32)+1)
+	r.Read(b)
+
+	var n Int256
+	_ = n.Scan(b)
+
+	return reflect.ValueOf(n)
+}
+
+func (n Int256) bigInt() *big.Int {
+	if n.x == nil {
+		return new(big.Int)
+	}
+
+	return n.x
+}
+
+func (n Int256) format() string {
+	return fmt.Sprintf("%#x", n.bigInt())
+}
+
+// parse parses s as an integer in base, without a prefix or underscores.
+func (n *Int256) parse(s string, base int) error {
+	if strings.Trim(strings.TrimPrefix(s, "-"), "0123456789abcdefABCDEF") != "" || strings.TrimPrefix(s, "-") == "" {
+		return fmt.Errorf("invalid source: invalid integer %q", s)
+	}
+
+	x, ok := new(big.Int).SetString(s, base)
+	if !ok {
+		return fmt.Errorf("invalid source: invalid integer %q", s)
+	}
+
+	v, err := NewInt256Checked(x, true)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	*n = v
+
+	return nil
+}
+
+// twosComplementBytes returns x in the shortest big-endian two's complement form.
+func twosComplementBytes(x *big.Int) []byte {
+	if x.Sign() >= 0 {
+		b := x.Bytes()
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0x00}, b...)
+		}
+
+		return b
+	}
+
+	// -x-1 is non-negative and fits in k bytes with a clear top bit.
+	k := new(big.Int).Not(x).BitLen()/8 + 1
+
+	b := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), uint(8*k)), x).Bytes()
+
+	return append(bytes.Repeat([]byte{0xff}, k-len(b)), b...)
+}
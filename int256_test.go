@@ -0,0 +1,666 @@
+package nullable_test
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/m0t0k1ch1-go/bigutil/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+var (
+	minInt256 = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+	maxInt256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+)
+
+func TestInt256(t *testing.T) {
+	var n nullable.Int256
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestInt256_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Int256]{
+		Valid: []nullable.Int256{
+			nullable.NewInt256FromInt64(0, true),
+			nullable.NewInt256FromInt64(-1, true),
+			nullable.NewInt256FromInt64(128, true),
+			nullable.MustNewInt256(minInt256, true),
+			nullable.MustNewInt256(maxInt256, true),
+		},
+		InvalidScan: []any{
+			[]byte{},
+			append([]byte{0x00}, bytes.Repeat([]byte{0xff}, 32)...),
+			"0x1",
+			"1" + strings.Repeat("0", 77),
+			float64(0),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`0.0`),
+			[]byte(`"0x"`),
+			[]byte(`"0x8` + strings.Repeat("0", 63) + `"`),
+			[]byte(`"-0x8` + strings.Repeat("0", 62) + `1"`),
+		},
+	})
+}
+
+func TestMustNewInt256(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		require.Panics(t, func() {
+			nullable.MustNewInt256(new(big.Int).Add(maxInt256, big.NewInt(1)), true)
+		})
+	})
+
+	t.Run("success", func(t *testing.T) {
+		require.Equal(t, nullable.NewString("0x0", true), nullable.MustNewInt256(nil, true).NullableString())
+		require.Equal(t, nullable.NewString("", false), nullable.MustNewInt256(new(big.Int).Add(maxInt256, big.NewInt(1)), false).NullableString())
+	})
+}
+
+func TestNewInt256Checked(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   *big.Int
+			want string
+		}{
+			{
+				"min - 1",
+				new(big.Int).Sub(minInt256, big.NewInt(1)),
+				"out of int256 range",
+			},
+			{
+				"max + 1",
+				new(big.Int).Add(maxInt256, big.NewInt(1)),
+				"out of int256 range",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := nullable.NewInt256Checked(tc.in, true)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name  string
+			in    *big.Int
+			valid bool
+			want  nullable.String
+		}{
+			{
+				"null",
+				new(big.Int).Add(maxInt256, big.NewInt(1)),
+				false,
+				nullable.NewString("", false),
+			},
+			{
+				"nil",
+				nil,
+				true,
+				nullable.NewString("0x0", true),
+			},
+			{
+				"min",
+				minInt256,
+				true,
+				nullable.NewString("-0x8"+strings.Repeat("0", 63), true),
+			},
+			{
+				"max",
+				maxInt256,
+				true,
+				nullable.NewString("0x7"+strings.Repeat("f", 63), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n, err := nullable.NewInt256Checked(tc.in, tc.valid)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n.NullableString())
+			})
+		}
+	})
+
+	t.Run("success: copies x", func(t *testing.T) {
+		x := big.NewInt(1)
+		n := nullable.MustNewInt256(x, true)
+
+		x.SetInt64(0)
+
+		require.Equal(t, big.NewInt(1), n.BigInt())
+	})
+}
+
+func TestNewInt256FromUint256(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := nullable.NewInt256FromUint256(nullable.NewUint256(bigutil.MustNewUint256(new(big.Int).Lsh(big.NewInt(1), 255)), true))
+		require.ErrorContains(t, err, "out of int256 range")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Uint256
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewUint256(bigutil.Uint256{}, false),
+				nullable.NewString("", false),
+			},
+			{
+				"zero",
+				nullable.NewUint256(bigutil.NewUint256FromUint64(0), true),
+				nullable.NewString("0x0", true),
+			},
+			{
+				"2^255 - 1",
+				nullable.NewUint256(bigutil.MustNewUint256(maxInt256), true),
+				nullable.NewString("0x7"+strings.Repeat("f", 63), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n, err := nullable.NewInt256FromUint256(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n.NullableString())
+			})
+		}
+	})
+}
+
+func TestInt256_BigInt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Int256
+			want *big.Int
+		}{
+			{
+				"null",
+				nullable.NewInt256FromInt64(1, false),
+				nil,
+			},
+			{
+				"nil",
+				nullable.Int256{Valid: true},
+				new(big.Int),
+			},
+			{
+				"min",
+				nullable.MustNewInt256(minInt256, true),
+				minInt256,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.BigInt())
+			})
+		}
+	})
+
+	t.Run("success: returns a copy", func(t *testing.T) {
+		n := nullable.NewInt256FromInt64(1, true)
+		n.BigInt().SetInt64(0)
+
+		require.Equal(t, big.NewInt(1), n.BigInt())
+	})
+}
+
+func TestInt256_Uint256(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := nullable.NewInt256FromInt64(-1, true).Uint256()
+		require.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Int256
+			want nullable.Uint256
+		}{
+			{
+				"null",
+				nullable.NewInt256FromInt64(0, false),
+				nullable.NewUint256(bigutil.Uint256{}, false),
+			},
+			{
+				"zero",
+				nullable.NewInt256FromInt64(0, true),
+				nullable.NewUint256(bigutil.NewUint256FromUint64(0), true),
+			},
+			{
+				"max",
+				nullable.MustNewInt256(maxInt256, true),
+				nullable.NewUint256(bigutil.MustNewUint256(maxInt256), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				u, err := tc.in.Uint256()
+				require.NoError(t, err)
+				require.Equal(t, tc.want.Valid, u.Valid)
+				require.Equal(t, tc.want.Uint256.String(), u.Uint256.String())
+			})
+		}
+	})
+}
+
+func TestInt256_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Int256
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewInt256FromInt64(0, false),
+				nil,
+			},
+			{
+				"zero",
+				nullable.NewInt256FromInt64(0, true),
+				[]byte{0x00},
+			},
+			{
+				"1",
+				nullable.NewInt256FromInt64(1, true),
+				[]byte{0x01},
+			},
+			{
+				"128",
+				nullable.NewInt256FromInt64(128, true),
+				[]byte{0x00, 0x80},
+			},
+			{
+				"-1",
+				nullable.NewInt256FromInt64(-1, true),
+				[]byte{0xff},
+			},
+			{
+				"-128",
+				nullable.NewInt256FromInt64(-128, true),
+				[]byte{0x80},
+			},
+			{
+				"-129",
+				nullable.NewInt256FromInt64(-129, true),
+				[]byte{0xff, 0x7f},
+			},
+			{
+				"min",
+				nullable.MustNewInt256(minInt256, true),
+				append([]byte{0x80}, bytes.Repeat([]byte{0x00}, 31)...),
+			},
+			{
+				"max",
+				nullable.MustNewInt256(maxInt256, true),
+				append([]byte{0x7f}, bytes.Repeat([]byte{0xff}, 31)...),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestInt256_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"float64",
+				float64(0),
+				"unsupported source type: float64",
+			},
+			{
+				"string: hex",
+				"0x1",
+				"invalid source",
+			},
+			{
+				"string: out of range",
+				"57896044618658097711785492504343953926634992332820282019728792003956564819968",
+				"out of int256 range",
+			},
+			{
+				"[]byte: empty",
+				[]byte{},
+				"invalid source: empty []byte",
+			},
+			{
+				"[]byte: exceeds 256 bits",
+				make([]byte, 33),
+				"exceeds 256 bits",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Int256
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.Int256
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewInt256FromInt64(0, false),
+			},
+			{
+				"int64",
+				int64(-31),
+				nullable.NewInt256FromInt64(-31, true),
+			},
+			{
+				"string: min",
+				minInt256.String(),
+				nullable.MustNewInt256(minInt256, true),
+			},
+			{
+				"[]byte: zero",
+				[]byte{0x00},
+				nullable.NewInt256FromInt64(0, true),
+			},
+			{
+				"[]byte: -1",
+				[]byte{0xff},
+				nullable.NewInt256FromInt64(-1, true),
+			},
+			{
+				"[]byte: -1, sign-extended",
+				[]byte{0xff, 0xff, 0xff},
+				nullable.NewInt256FromInt64(-1, true),
+			},
+			{
+				"[]byte: 128",
+				[]byte{0x00, 0x80},
+				nullable.NewInt256FromInt64(128, true),
+			},
+			{
+				"[]byte: min",
+				append([]byte{0x80}, bytes.Repeat([]byte{0x00}, 31)...),
+				nullable.MustNewInt256(minInt256, true),
+			},
+			{
+				"[]byte: max",
+				append([]byte{0x7f}, bytes.Repeat([]byte{0xff}, 31)...),
+				nullable.MustNewInt256(maxInt256, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Int256
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.NullableString(), n.NullableString())
+			})
+		}
+	})
+}
+
+func TestInt256_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Int256
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewInt256FromInt64(0, false),
+				[]byte(`null`),
+			},
+			{
+				"zero",
+				nullable.NewInt256FromInt64(0, true),
+				[]byte(`"0x0"`),
+			},
+			{
+				"negative",
+				nullable.NewInt256FromInt64(-31, true),
+				[]byte(`"-0x1f"`),
+			},
+			{
+				"max",
+				nullable.MustNewInt256(maxInt256, true),
+				[]byte(`"0x7` + strings.Repeat("f", 63) + `"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestInt256_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"empty",
+				[]byte{},
+				"invalid JSON",
+			},
+			{
+				"boolean",
+				[]byte(`true`),
+				"invalid integer",
+			},
+			{
+				"number: fraction",
+				[]byte(`0.0`),
+				"invalid integer",
+			},
+			{
+				"number: exponent",
+				[]byte(`1e3`),
+				"invalid integer",
+			},
+			{
+				"number: max + 1",
+				[]byte(`57896044618658097711785492504343953926634992332820282019728792003956564819968`),
+				"out of int256 range",
+			},
+			{
+				"string: empty",
+				[]byte(`""`),
+				"invalid integer",
+			},
+			{
+				"string: plus sign",
+				[]byte(`"+1"`),
+				"invalid integer",
+			},
+			{
+				"string: underscore",
+				[]byte(`"1_000"`),
+				"invalid integer",
+			},
+			{
+				"string: empty hex",
+				[]byte(`"0x"`),
+				"invalid integer",
+			},
+			{
+				"string: double sign",
+				[]byte(`"--0x1"`),
+				"invalid integer",
+			},
+			{
+				"string: min - 1",
+				[]byte(`"-0x8` + strings.Repeat("0", 62) + `1"`),
+				"out of int256 range",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Int256
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Int256
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewInt256FromInt64(0, false),
+			},
+			{
+				"number",
+				[]byte(`-31`),
+				nullable.NewInt256FromInt64(-31, true),
+			},
+			{
+				"number: min",
+				[]byte(`-57896044618658097711785492504343953926634992332820282019728792003956564819968`),
+				nullable.MustNewInt256(minInt256, true),
+			},
+			{
+				"string: decimal",
+				[]byte(`"-31"`),
+				nullable.NewInt256FromInt64(-31, true),
+			},
+			{
+				"string: hex",
+				[]byte(`"-0x1F"`),
+				nullable.NewInt256FromInt64(-31, true),
+			},
+			{
+				"string: hex max",
+				[]byte(`"0x7` + strings.Repeat("f", 63) + `"`),
+				nullable.MustNewInt256(maxInt256, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Int256
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.NullableString(), n.NullableString())
+			})
+		}
+	})
+}
+
+func TestInt256_Generate(t *testing.T) {
+	ns := generate[nullable.Int256](t, 1000)
+
+	ss := make([]nullable.String, len(ns))
+	for i, n := range ns {
+		ss[i] = n.NullableString()
+	}
+
+	tcs := []struct {
+		name string
+		want nullable.Int256
+	}{
+		{
+			"null",
+			nullable.NewInt256FromInt64(0, false),
+		},
+		{
+			"0",
+			nullable.NewInt256FromInt64(0, true),
+		},
+		{
+			"1",
+			nullable.NewInt256FromInt64(1, true),
+		},
+		{
+			"-1",
+			nullable.NewInt256FromInt64(-1, true),
+		},
+		{
+			"min",
+			nullable.MustNewInt256(minInt256, true),
+		},
+		{
+			"max",
+			nullable.MustNewInt256(maxInt256, true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ss, tc.want.NullableString())
+		})
+	}
+}
+
+func FuzzInt256_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Int256](f,
+		[]byte{},
+		[]byte{0x00},
+		[]byte{0xff},
+		[]byte{0x00, 0x80},
+		append([]byte{0x80}, bytes.Repeat([]byte{0x00}, 31)...),
+		make([]byte, 33),
+	)
+}
+
+func FuzzInt256_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Int256](f,
+		[]byte{},
+		[]byte(`0.0`),
+		[]byte(`"0x"`),
+		[]byte(`null`),
+		[]byte(`-31`),
+		[]byte(`"-31"`),
+		[]byte(`"-0x1F"`),
+		[]byte(`"0x7`+strings.Repeat("f", 63)+`"`),
+	)
+}
@@ -0,0 +1,92 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+)
+
+// Int256Numeric represents a nullable signed 256-bit integer stored as a decimal integer,
+// for NUMERIC(78, 0) columns.
+// It is encoded in JSON exactly as Int256, and converts to and from it.
+type Int256Numeric Int256
+
+// NewInt256NumericChecked returns a new Int256Numeric holding a copy of x; a nil x is taken as 0.
+// It returns an error if valid and x is out of the int256 range, as NewInt256Checked does.
+func NewInt256NumericChecked(x *big.Int, valid bool) (Int256Numeric, error) {
+	n, err := NewInt256Checked(x, valid)
+
+	return Int256Numeric(n), err
+}
+
+// MustNewInt256Numeric is NewInt256NumericChecked, except that it panics if valid and x is out of the int256 range.
+// It is meant for constants and other x known to be in range.
+func MustNewInt256Numeric(x *big.Int, valid bool) Int256Numeric {
+	return Int256Numeric(MustNewInt256(x, valid))
+}
+
+// BigInt returns a copy of the value as a *big.Int, or nil if invalid.
+func (n Int256Numeric) BigInt() *big.Int {
+	return Int256(n).BigInt()
+}
+
+// NullableString returns the value as a String in 0x-prefixed hex, as Int256.NullableString does.
+func (n Int256Numeric) NullableString() String {
+	return Int256(n).NullableString()
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a decimal string, or nil if invalid.
+func (n Int256Numeric) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return Int256(n).bigInt().String(), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts one of the following:
+//   - int64
+//   - string (decimal integer)
+//   - []byte (decimal integer, as NUMERIC columns return)
+//   - nil
+func (n *Int256Numeric) Scan(src any) error {
+	if src == nil {
+		*n = Int256Numeric{}
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case int64, string:
+		return (*Int256)(n).Scan(v)
+
+	case []byte:
+		return (*Int256)(n).parse(string(v), 10)
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the same JSON as Int256.MarshalJSON.
+func (n Int256Numeric) MarshalJSON() ([]byte, error) {
+	return Int256(n).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts the same JSON values as Int256.UnmarshalJSON.
+func (n *Int256Numeric) UnmarshalJSON(b []byte) error {
+	return (*Int256)(n).UnmarshalJSON(b)
+}
+
+// Generate implements quick.Generator.
+// It returns the same values as Int256.Generate.
+func (Int256Numeric) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(Int256Numeric(Int256{}.Generate(r, size).Interface().(Int256)))
+}
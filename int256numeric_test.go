@@ -0,0 +1,188 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestInt256Numeric(t *testing.T) {
+	var n nullable.Int256Numeric
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestInt256Numeric_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Int256Numeric]{
+		Valid: []nullable.Int256Numeric{
+			nullable.MustNewInt256Numeric(big.NewInt(0), true),
+			nullable.MustNewInt256Numeric(big.NewInt(-1), true),
+			nullable.MustNewInt256Numeric(minInt256, true),
+			nullable.MustNewInt256Numeric(maxInt256, true),
+		},
+		InvalidScan: []any{
+			[]byte{},
+			[]byte{0x01},
+			[]byte("1" + strings.Repeat("0", 77)),
+			float64(0),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`0.0`),
+			[]byte(`"0x"`),
+		},
+	})
+}
+
+func TestMustNewInt256Numeric(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		require.Panics(t, func() {
+			nullable.MustNewInt256Numeric(new(big.Int).Sub(minInt256, big.NewInt(1)), true)
+		})
+	})
+
+	t.Run("success", func(t *testing.T) {
+		require.Equal(t, nullable.NewString("0x0", true), nullable.MustNewInt256Numeric(nil, true).NullableString())
+	})
+}
+
+func TestNewInt256NumericChecked(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := nullable.NewInt256NumericChecked(new(big.Int).Add(maxInt256, big.NewInt(1)), true)
+		require.ErrorContains(t, err, "out of int256 range")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		n, err := nullable.NewInt256NumericChecked(maxInt256, true)
+		require.NoError(t, err)
+		require.Equal(t, nullable.MustNewInt256Numeric(maxInt256, true), n)
+
+		n, err = nullable.NewInt256NumericChecked(new(big.Int).Add(maxInt256, big.NewInt(1)), false)
+		require.NoError(t, err)
+		require.Equal(t, nullable.MustNewInt256Numeric(nil, false), n)
+	})
+}
+
+func TestInt256Numeric_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Int256Numeric
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.MustNewInt256Numeric(nil, false),
+				nil,
+			},
+			{
+				"zero",
+				nullable.Int256Numeric{Valid: true},
+				"0",
+			},
+			{
+				"min",
+				nullable.MustNewInt256Numeric(minInt256, true),
+				"-57896044618658097711785492504343953926634992332820282019728792003956564819968",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestInt256Numeric_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"float64",
+				float64(0),
+				"unsupported source type: float64",
+			},
+			{
+				"[]byte: binary",
+				[]byte{0xff},
+				"invalid source",
+			},
+			{
+				"[]byte: out of range",
+				[]byte(maxInt256.String() + "0"),
+				"out of int256 range",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Int256Numeric
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.Int256Numeric
+		}{
+			{
+				"nil",
+				nil,
+				nullable.MustNewInt256Numeric(nil, false),
+			},
+			{
+				"int64",
+				int64(-31),
+				nullable.MustNewInt256Numeric(big.NewInt(-31), true),
+			},
+			{
+				"string",
+				"-31",
+				nullable.MustNewInt256Numeric(big.NewInt(-31), true),
+			},
+			{
+				"[]byte: max",
+				[]byte(maxInt256.String()),
+				nullable.MustNewInt256Numeric(maxInt256, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Int256Numeric
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.NullableString(), n.NullableString())
+			})
+		}
+	})
+}
+
+func FuzzInt256Numeric_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Int256Numeric](f,
+		[]byte("0"),
+		[]byte("-31"),
+		[]byte(maxInt256.String()),
+		[]byte("0x1f"),
+	)
+}
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"math"
+	"math/rand"
+	"reflect"
 )
 
 // Int32 represents a nullable int32 wrapping sql.NullInt32.
@@ -68,3 +71,17 @@ func (n *Int32) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (0, ±1, math.MinInt32 or math.MaxInt32), or a random int32.
+func (Int32) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewInt32(0, false))
+	}
+
+	if i, ok := generateEdge[int32](r, 0, 1, -1, math.MinInt32, math.MaxInt32); ok {
+		return reflect.ValueOf(NewInt32(i, true))
+	}
+
+	return reflect.ValueOf(NewInt32(int32(r.Uint32()), true))
+}
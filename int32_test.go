@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
 )
 
 func TestInt32(t *testing.T) {
@@ -20,6 +21,25 @@ func TestInt32(t *testing.T) {
 	require.Implements(t, (*json.Unmarshaler)(nil), &n)
 }
 
+func TestInt32_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Int32]{
+		Valid: []nullable.Int32{
+			nullable.NewInt32(0, true),
+			nullable.NewInt32(math.MinInt32, true),
+			nullable.NewInt32(math.MaxInt32, true),
+		},
+		InvalidScan: []any{
+			"invalid",
+			int64(math.MaxInt32) + 1,
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`2147483648`),
+			[]byte(`"0"`),
+		},
+	})
+}
+
 func TestNewInt32FromInt32Ptr(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -233,3 +253,66 @@ func TestInt32_UnmarshalJSON(t *testing.T) {
 		}
 	})
 }
+
+func TestInt32_Generate(t *testing.T) {
+	ns := generate[nullable.Int32](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Int32
+	}{
+		{
+			"null",
+			nullable.NewInt32(0, false),
+		},
+		{
+			"0",
+			nullable.NewInt32(0, true),
+		},
+		{
+			"1",
+			nullable.NewInt32(1, true),
+		},
+		{
+			"-1",
+			nullable.NewInt32(-1, true),
+		},
+		{
+			"min",
+			nullable.NewInt32(math.MinInt32, true),
+		},
+		{
+			"max",
+			nullable.NewInt32(math.MaxInt32, true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzInt32_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Int32](f,
+		[]byte("0"),
+		[]byte("-2147483648"),
+		[]byte("2147483647"),
+		[]byte("2147483648"),
+		[]byte("invalid"),
+	)
+}
+
+func FuzzInt32_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Int32](f,
+		[]byte(`true`),
+		[]byte(`-2147483649`),
+		[]byte(`2147483648`),
+		[]byte(`"0"`),
+		[]byte(`null`),
+		[]byte(`0`),
+		[]byte(`-2147483648`),
+		[]byte(`2147483647`),
+	)
+}
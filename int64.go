@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"math"
+	"math/rand"
+	"reflect"
 )
 
 // Int64 represents a nullable int64 wrapping sql.NullInt64.
@@ -68,3 +71,17 @@ func (n *Int64) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (0, ±1, math.MinInt64 or math.MaxInt64), or a random int64.
+func (Int64) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewInt64(0, false))
+	}
+
+	if i, ok := generateEdge[int64](r, 0, 1, -1, math.MinInt64, math.MaxInt64); ok {
+		return reflect.ValueOf(NewInt64(i, true))
+	}
+
+	return reflect.ValueOf(NewInt64(int64(r.Uint64()), true))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
 )
 
 func TestInt64(t *testing.T) {
@@ -20,6 +21,24 @@ func TestInt64(t *testing.T) {
 	require.Implements(t, (*json.Unmarshaler)(nil), &n)
 }
 
+func TestInt64_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Int64]{
+		Valid: []nullable.Int64{
+			nullable.NewInt64(0, true),
+			nullable.NewInt64(math.MinInt64, true),
+			nullable.NewInt64(math.MaxInt64, true),
+		},
+		InvalidScan: []any{
+			"invalid",
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`9223372036854775808`),
+			[]byte(`"0"`),
+		},
+	})
+}
+
 func TestNewInt64FromInt64Ptr(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -233,3 +252,66 @@ func TestInt64_UnmarshalJSON(t *testing.T) {
 		}
 	})
 }
+
+func TestInt64_Generate(t *testing.T) {
+	ns := generate[nullable.Int64](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Int64
+	}{
+		{
+			"null",
+			nullable.NewInt64(0, false),
+		},
+		{
+			"0",
+			nullable.NewInt64(0, true),
+		},
+		{
+			"1",
+			nullable.NewInt64(1, true),
+		},
+		{
+			"-1",
+			nullable.NewInt64(-1, true),
+		},
+		{
+			"min",
+			nullable.NewInt64(math.MinInt64, true),
+		},
+		{
+			"max",
+			nullable.NewInt64(math.MaxInt64, true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzInt64_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Int64](f,
+		[]byte("0"),
+		[]byte("-9223372036854775808"),
+		[]byte("9223372036854775807"),
+		[]byte("9223372036854775808"),
+		[]byte("invalid"),
+	)
+}
+
+func FuzzInt64_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Int64](f,
+		[]byte(`true`),
+		[]byte(`-9223372036854775809`),
+		[]byte(`9223372036854775808`),
+		[]byte(`"0"`),
+		[]byte(`null`),
+		[]byte(`0`),
+		[]byte(`-9223372036854775808`),
+		[]byte(`9223372036854775807`),
+	)
+}
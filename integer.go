@@ -0,0 +1,63 @@
+package nullable
+
+import (
+	"fmt"
+	"strconv"
+)
+
+//go:generate go run ./internal/cmd/intgen
+
+// integer is the constraint satisfied by the integers wrapped by the types generated by intgen.
+type integer interface {
+	~int8 | ~int16 | ~uint8 | ~uint16 | ~uint32
+}
+
+// scanInteger converts a Scan source to T, rejecting values out of its range.
+func scanInteger[T integer](src any) (T, error) {
+	switch v := src.(type) {
+
+	case int64:
+		return integerFromInt64[T](v)
+
+	case uint64:
+		return integerFromUint64[T](v)
+
+	case string:
+		return parseInteger[T](v)
+
+	case []byte:
+		return parseInteger[T](string(v))
+
+	default:
+		return 0, fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+func parseInteger[T integer](s string) (T, error) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return integerFromInt64[T](i)
+	}
+
+	u, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid source: %w", err)
+	}
+
+	return integerFromUint64[T](u)
+}
+
+func integerFromInt64[T integer](i int64) (T, error) {
+	if t := T(i); int64(t) == i {
+		return t, nil
+	}
+
+	return 0, fmt.Errorf("invalid source: %d overflows %T", i, T(0))
+}
+
+func integerFromUint64[T integer](u uint64) (T, error) {
+	if t := T(u); t >= 0 && uint64(t) == u {
+		return t, nil
+	}
+
+	return 0, fmt.Errorf("invalid source: %d overflows %T", u, T(0))
+}
@@ -0,0 +1,170 @@
+// Command intgen generates the fixed-width integer types of the nullable package
+// (Int8, Int16, Uint8, Uint16 and Uint32) and their tests from a single template,
+// so that their behavior stays identical.
+//
+// Usage:
+//
+//	go run ./internal/cmd/intgen [-o dir]
+//
+// It is run by go generate in the root of the module.
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+//go:embed integer.go.tmpl
+var typeTemplateText string
+
+//go:embed integer_test.go.tmpl
+var testTemplateText string
+
+var (
+	typeTemplate = template.Must(template.New("type").Parse(typeTemplateText))
+	testTemplate = template.Must(template.New("test").Parse(testTemplateText))
+)
+
+// intTypes are the generated types.
+var intTypes = []intType{
+	{"Int8", 8, true},
+	{"Int16", 16, true},
+	{"Uint8", 8, false},
+	{"Uint16", 16, false},
+	{"Uint32", 32, false},
+}
+
+func main() {
+	out := flag.String("o", ".", "output directory")
+	flag.Parse()
+
+	files, err := Generate()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "intgen:", err)
+		os.Exit(1)
+	}
+
+	for _, f := range files {
+		if err := os.WriteFile(filepath.Join(*out, f.Name), f.Content, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, "intgen:", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// File represents a generated file.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Generate returns the file and the test file of each generated type.
+func Generate() ([]File, error) {
+	var files []File
+	for _, t := range intTypes {
+		base := strings.ToLower(t.Name)
+
+		src, err := execute(typeTemplate, t)
+		if err != nil {
+			return nil, err
+		}
+
+		testSrc, err := execute(testTemplate, t)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files,
+			File{Name: base + ".go", Content: src},
+			File{Name: base + "_test.go", Content: testSrc},
+		)
+	}
+
+	return files, nil
+}
+
+// intType is the template data of a generated type.
+type intType struct {
+	Name   string
+	Bits   uint
+	Signed bool
+}
+
+// Type returns the wrapped Go type, e.g. int8.
+func (t intType) Type() string {
+	return strings.ToLower(t.Name)
+}
+
+// MinConst returns the expression of the minimum value, e.g. math.MinInt8.
+func (t intType) MinConst() string {
+	if !t.Signed {
+		return "0"
+	}
+
+	return "math.Min" + t.Name
+}
+
+// MaxConst returns the expression of the maximum value, e.g. math.MaxInt8.
+func (t intType) MaxConst() string {
+	return "math.Max" + t.Name
+}
+
+// Min returns the minimum value in decimal.
+func (t intType) Min() string {
+	return t.min().String()
+}
+
+// Max returns the maximum value in decimal.
+func (t intType) Max() string {
+	return t.max().String()
+}
+
+// BelowMin returns the minimum value minus one in decimal.
+func (t intType) BelowMin() string {
+	return new(big.Int).Sub(t.min(), big.NewInt(1)).String()
+}
+
+// AboveMax returns the maximum value plus one in decimal.
+func (t intType) AboveMax() string {
+	return new(big.Int).Add(t.max(), big.NewInt(1)).String()
+}
+
+func (t intType) min() *big.Int {
+	if !t.Signed {
+		return new(big.Int)
+	}
+
+	return new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), t.Bits-1))
+}
+
+func (t intType) max() *big.Int {
+	bits := t.Bits
+	if t.Signed {
+		bits--
+	}
+
+	return new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits), big.NewInt(1))
+}
+
+func execute(tmpl *template.Template, data intType) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to format generated code"), err)
+	}
+
+	return src, nil
+}
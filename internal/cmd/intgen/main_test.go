@@ -0,0 +1,23 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	files, err := Generate()
+	require.NoError(t, err)
+	require.Len(t, files, 2*len(intTypes))
+
+	for _, f := range files {
+		t.Run(f.Name, func(t *testing.T) {
+			want, err := os.ReadFile(filepath.Join("..", "..", "..", f.Name))
+			require.NoError(t, err)
+			require.Equal(t, string(want), string(f.Content), "%s is out of date; run go generate", f.Name)
+		})
+	}
+}
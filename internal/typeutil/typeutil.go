@@ -0,0 +1,137 @@
+// Package typeutil provides helpers shared by the nullable tools for inspecting Go types.
+package typeutil
+
+import (
+	"cmp"
+	"fmt"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// NullablePkgPath is the import path of the nullable package.
+const NullablePkgPath = "github.com/m0t0k1ch1-go/nullable/v3"
+
+// LoadPackages loads the packages matching the patterns.
+// It returns an error if any of them failed to load.
+func LoadPackages(mode packages.LoadMode, patterns ...string) ([]*packages.Package, error) {
+	pkgs, err := packages.Load(&packages.Config{Mode: mode}, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load packages: %w", err)
+	}
+
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("failed to load package %s: %w", pkg.PkgPath, pkg.Errors[0])
+		}
+	}
+
+	return pkgs, nil
+}
+
+// NullableName returns the name of t if it is a type declared in the nullable package.
+func NullableName(t types.Type) (string, bool) {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return "", false
+	}
+
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != NullablePkgPath {
+		return "", false
+	}
+
+	return obj.Name(), true
+}
+
+// IsNullable reports whether t is a type declared in the nullable package.
+func IsNullable(t types.Type) bool {
+	_, ok := NullableName(t)
+
+	return ok
+}
+
+// IsNamed reports whether t is the named type pkgPath.name.
+func IsNamed(t types.Type, pkgPath, name string) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == pkgPath && obj.Name() == name
+}
+
+// StructsWithNullableFields returns the exported, non-generic struct types declared in pkg
+// that have at least one nullable field, in source order.
+func StructsWithNullableFields(pkg *types.Package) []*types.TypeName {
+	var objs []*types.TypeName
+
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		obj, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || !obj.Exported() || obj.IsAlias() {
+			continue
+		}
+
+		named, ok := obj.Type().(*types.Named)
+		if !ok || named.TypeParams().Len() > 0 || IsNullable(named) {
+			continue
+		}
+
+		st, ok := named.Underlying().(*types.Struct)
+		if !ok || !hasNullableField(st, map[*types.Struct]bool{}) {
+			continue
+		}
+
+		objs = append(objs, obj)
+	}
+
+	slices.SortFunc(objs, func(a, b *types.TypeName) int {
+		return cmp.Compare(a.Pos(), b.Pos())
+	})
+
+	return objs
+}
+
+func hasNullableField(st *types.Struct, visited map[*types.Struct]bool) bool {
+	if visited[st] {
+		return false
+	}
+	visited[st] = true
+
+	for i := range st.NumFields() {
+		t := Elem(st.Field(i).Type())
+
+		if IsNullable(t) {
+			return true
+		}
+		if st.Field(i).Anonymous() {
+			if est, ok := t.Underlying().(*types.Struct); ok && hasNullableField(est, visited) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Elem strips pointer, slice, array and map layers from t.
+func Elem(t types.Type) types.Type {
+	for {
+		switch u := t.(type) {
+		case *types.Pointer:
+			t = u.Elem()
+		case *types.Slice:
+			t = u.Elem()
+		case *types.Array:
+			t = u.Elem()
+		case *types.Map:
+			t = u.Elem()
+		default:
+			return t
+		}
+	}
+}
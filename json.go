@@ -0,0 +1,139 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+)
+
+// JSON represents a nullable raw JSON value, for JSON and JSONB columns.
+//
+// Unlike json.RawMessage, it distinguishes SQL NULL from the JSON literal null:
+// SQL NULL is an invalid JSON, whereas a column holding the JSON literal null
+// scans into a valid JSON whose value is null.
+// Within a JSON document, however, both are null: MarshalJSON returns null for either,
+// and UnmarshalJSON takes null as SQL NULL.
+type JSON struct {
+	JSON  json.RawMessage
+	Valid bool
+}
+
+// NewJSON returns a new JSON.
+func NewJSON(raw json.RawMessage, valid bool) JSON {
+	return JSON{
+		JSON:  raw,
+		Valid: valid,
+	}
+}
+
+// IsJSONNull reports whether the value is valid and the JSON literal null.
+func (n JSON) IsJSONNull() bool {
+	return n.Valid && bytes.Equal(bytes.TrimSpace(n.JSON), []byte("null"))
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a string, or nil if invalid.
+// It returns an error if the value is not valid JSON.
+func (n JSON) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	if !json.Valid(n.JSON) {
+		return nil, errInvalidJSON
+	}
+
+	return string(n.JSON), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts one of the following:
+//   - []byte (valid JSON, copied, as the driver may reuse it)
+//   - string (valid JSON)
+//   - nil
+//
+// A source of the JSON literal null scans into a valid JSON, unlike nil.
+func (n *JSON) Scan(src any) error {
+	if src == nil {
+		n.JSON, n.Valid = nil, false
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case []byte:
+		return n.set(v)
+
+	case string:
+		return n.set([]byte(v))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as it is, or null if invalid.
+func (n JSON) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	if !json.Valid(n.JSON) {
+		return nil, errInvalidJSON
+	}
+
+	return n.JSON, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts any JSON value, taking null as SQL NULL.
+func (n *JSON) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.JSON, n.Valid = nil, false
+
+		return nil
+	}
+
+	return n.set(b)
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (the JSON literal null, an empty string, 0, false,
+// an empty object or an empty array), or a random JSON number or string.
+func (JSON) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewJSON(nil, false))
+	}
+
+	if s, ok := generateEdge(r, `null`, `""`, `0`, `false`, `{}`, `[]`); ok {
+		return reflect.ValueOf(NewJSON(json.RawMessage(s), true))
+	}
+
+	var v any = r.NormFloat64()
+	if r.Intn(2) == 0 {
+		v = fmt.Sprintf("%x", r.Uint64())
+	}
+
+	b, _ := json.Marshal(v)
+
+	return reflect.ValueOf(NewJSON(b, true))
+}
+
+var errInvalidJSON = errors.New("invalid JSON")
+
+// set sets the value to a copy of b if b is valid JSON.
+func (n *JSON) set(b []byte) error {
+	if !json.Valid(b) {
+		return fmt.Errorf("invalid source: %w", errInvalidJSON)
+	}
+
+	n.JSON, n.Valid = append(json.RawMessage{}, b...), true
+
+	return nil
+}
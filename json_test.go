@@ -0,0 +1,388 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestJSON(t *testing.T) {
+	var n nullable.JSON
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestJSON_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.JSON]{
+		Valid: []nullable.JSON{
+			nullable.NewJSON(json.RawMessage(`""`), true),
+			nullable.NewJSON(json.RawMessage(`0`), true),
+			nullable.NewJSON(json.RawMessage(`false`), true),
+			nullable.NewJSON(json.RawMessage(`{}`), true),
+			nullable.NewJSON(json.RawMessage(`{"a":[1,true,null]}`), true),
+		},
+		InvalidScan: []any{
+			"",
+			"{",
+			[]byte("undefined"),
+			int64(0),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(``),
+			[]byte(`{`),
+		},
+	})
+}
+
+func TestJSON_IsJSONNull(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.JSON
+			want bool
+		}{
+			{
+				"SQL NULL",
+				nullable.NewJSON(nil, false),
+				false,
+			},
+			{
+				"JSON null",
+				nullable.NewJSON(json.RawMessage(`null`), true),
+				true,
+			},
+			{
+				"JSON null: with whitespace",
+				nullable.NewJSON(json.RawMessage(" null\n"), true),
+				true,
+			},
+			{
+				"JSON string",
+				nullable.NewJSON(json.RawMessage(`"null"`), true),
+				false,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.IsJSONNull())
+			})
+		}
+	})
+}
+
+func TestJSON_Value(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.JSON
+			want string
+		}{
+			{
+				"empty",
+				nullable.NewJSON(nil, true),
+				"invalid JSON",
+			},
+			{
+				"invalid",
+				nullable.NewJSON(json.RawMessage(`{`), true),
+				"invalid JSON",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.Value()
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.JSON
+			want driver.Value
+		}{
+			{
+				"SQL NULL",
+				nullable.NewJSON(nil, false),
+				nil,
+			},
+			{
+				"JSON null",
+				nullable.NewJSON(json.RawMessage(`null`), true),
+				`null`,
+			},
+			{
+				"object",
+				nullable.NewJSON(json.RawMessage(`{"a":[1,true,null]}`), true),
+				`{"a":[1,true,null]}`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestJSON_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"int64",
+				int64(0),
+				"unsupported source type: int64",
+			},
+			{
+				"string: empty",
+				"",
+				"invalid source: invalid JSON",
+			},
+			{
+				"[]byte: invalid",
+				[]byte(`{"a":}`),
+				"invalid source: invalid JSON",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.JSON
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.JSON
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewJSON(nil, false),
+			},
+			{
+				"string: JSON null",
+				`null`,
+				nullable.NewJSON(json.RawMessage(`null`), true),
+			},
+			{
+				"[]byte: JSON null",
+				[]byte(`null`),
+				nullable.NewJSON(json.RawMessage(`null`), true),
+			},
+			{
+				"[]byte: object",
+				[]byte(`{"a": [1, true, null]}`),
+				nullable.NewJSON(json.RawMessage(`{"a": [1, true, null]}`), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.JSON
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+
+	t.Run("success: copies the source", func(t *testing.T) {
+		src := []byte(`[0]`)
+
+		var n nullable.JSON
+		require.NoError(t, n.Scan(src))
+
+		src[1] = '1'
+
+		require.Equal(t, json.RawMessage(`[0]`), n.JSON)
+	})
+}
+
+func TestJSON_MarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := nullable.NewJSON(json.RawMessage(`{`), true).MarshalJSON()
+		require.ErrorContains(t, err, "invalid JSON")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.JSON
+			want []byte
+		}{
+			{
+				"SQL NULL",
+				nullable.NewJSON(nil, false),
+				[]byte(`null`),
+			},
+			{
+				"JSON null",
+				nullable.NewJSON(json.RawMessage(`null`), true),
+				[]byte(`null`),
+			},
+			{
+				"object",
+				nullable.NewJSON(json.RawMessage(`{"a":[1,true,null]}`), true),
+				[]byte(`{"a":[1,true,null]}`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestJSON_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"empty",
+				[]byte(``),
+				"invalid source: invalid JSON",
+			},
+			{
+				"invalid",
+				[]byte(`[1,]`),
+				"invalid source: invalid JSON",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.JSON
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.JSON
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewJSON(nil, false),
+			},
+			{
+				"string",
+				[]byte(`"null"`),
+				nullable.NewJSON(json.RawMessage(`"null"`), true),
+			},
+			{
+				"object",
+				[]byte(`{"a":[1,true,null]}`),
+				nullable.NewJSON(json.RawMessage(`{"a":[1,true,null]}`), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.JSON
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+
+	t.Run("success: in a struct", func(t *testing.T) {
+		var v struct {
+			A nullable.JSON `json:"a"`
+			B nullable.JSON `json:"b"`
+			C nullable.JSON `json:"c"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(`{"a":null,"b":{"x":1}}`), &v))
+
+		require.Equal(t, nullable.NewJSON(nil, false), v.A)
+		require.Equal(t, nullable.NewJSON(json.RawMessage(`{"x":1}`), true), v.B)
+		require.Equal(t, nullable.NewJSON(nil, false), v.C)
+	})
+}
+
+func TestJSON_Generate(t *testing.T) {
+	ns := generate[nullable.JSON](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.JSON
+	}{
+		{
+			"SQL NULL",
+			nullable.NewJSON(nil, false),
+		},
+		{
+			"JSON null",
+			nullable.NewJSON(json.RawMessage(`null`), true),
+		},
+		{
+			"empty object",
+			nullable.NewJSON(json.RawMessage(`{}`), true),
+		},
+		{
+			"empty array",
+			nullable.NewJSON(json.RawMessage(`[]`), true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzJSON_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.JSON](f,
+		[]byte(""),
+		[]byte("null"),
+		[]byte(`{"a":[1,true,null]}`),
+		[]byte("{"),
+	)
+}
+
+func FuzzJSON_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.JSON](f,
+		[]byte(``),
+		[]byte(`{`),
+		[]byte(`null`),
+		[]byte(`""`),
+		[]byte(`{"a":[1,true,null]}`),
+	)
+}
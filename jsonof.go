@@ -0,0 +1,120 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing/quick"
+)
+
+// JSONOf represents a nullable Go value of type T stored as JSON, for JSON and JSONB columns.
+//
+// As with JSON, SQL NULL is an invalid JSONOf, whereas a column holding the JSON literal null
+// scans into a valid JSONOf whose V is the zero value of T.
+// Within a JSON document, both are null, and UnmarshalJSON takes null as SQL NULL.
+type JSONOf[T any] struct {
+	V     T
+	Valid bool
+}
+
+// NewJSONOf returns a new JSONOf.
+func NewJSONOf[T any](v T, valid bool) JSONOf[T] {
+	return JSONOf[T]{
+		V:     v,
+		Valid: valid,
+	}
+}
+
+// Value implements driver.Valuer.
+// It returns the JSON encoding of V as a string, or nil if invalid.
+func (n JSONOf[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(n.V)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts one of the following:
+//   - []byte (JSON decodable into T)
+//   - string (JSON decodable into T)
+//   - nil
+func (n *JSONOf[T]) Scan(src any) error {
+	if src == nil {
+		*n = JSONOf[T]{}
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case []byte:
+		return n.decode(v)
+
+	case string:
+		return n.decode([]byte(v))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the JSON encoding of V, or null if invalid.
+func (n JSONOf[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts any JSON value decodable into T, taking null as SQL NULL.
+func (n *JSONOf[T]) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = JSONOf[T]{}
+
+		return nil
+	}
+
+	return n.decode(b)
+}
+
+// Generate implements quick.Generator.
+// It returns null, or a random T as testing/quick.Value generates it,
+// which calls Generate if T implements quick.Generator.
+// It returns null if testing/quick cannot generate T, e.g. if T is an interface or a func.
+func (JSONOf[T]) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(JSONOf[T]{})
+	}
+
+	v, ok := quick.Value(reflect.TypeFor[T](), r)
+	if !ok {
+		return reflect.ValueOf(JSONOf[T]{})
+	}
+
+	return reflect.ValueOf(NewJSONOf(v.Interface().(T), true))
+}
+
+// decode sets V to b decoded into a new T.
+func (n *JSONOf[T]) decode(b []byte) error {
+	var v T
+	if err := json.Unmarshal(b, &v); err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	n.V, n.Valid = v, true
+
+	return nil
+}
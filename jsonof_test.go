@@ -0,0 +1,315 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+type jsonOfPayload struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+func TestJSONOf(t *testing.T) {
+	var n nullable.JSONOf[jsonOfPayload]
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestJSONOf_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.JSONOf[jsonOfPayload]]{
+		Valid: []nullable.JSONOf[jsonOfPayload]{
+			nullable.NewJSONOf(jsonOfPayload{}, true),
+			nullable.NewJSONOf(jsonOfPayload{Name: "m0t0k1ch1", Tags: []string{"a", "b"}}, true),
+		},
+		InvalidScan: []any{
+			"",
+			"[]",
+			[]byte(`{"name":0}`),
+			int64(0),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`{"tags":"a"}`),
+		},
+	})
+}
+
+func TestJSONOf_Value(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := nullable.NewJSONOf(func() {}, true).Value()
+		require.ErrorContains(t, err, "unsupported type")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.JSONOf[jsonOfPayload]
+			want driver.Value
+		}{
+			{
+				"SQL NULL",
+				nullable.NewJSONOf(jsonOfPayload{}, false),
+				nil,
+			},
+			{
+				"zero",
+				nullable.NewJSONOf(jsonOfPayload{}, true),
+				`{"name":"","tags":null}`,
+			},
+			{
+				"non-zero",
+				nullable.NewJSONOf(jsonOfPayload{Name: "m0t0k1ch1", Tags: []string{"a"}}, true),
+				`{"name":"m0t0k1ch1","tags":["a"]}`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestJSONOf_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"int64",
+				int64(0),
+				"unsupported source type: int64",
+			},
+			{
+				"string: invalid JSON",
+				"{",
+				"invalid source",
+			},
+			{
+				"[]byte: mismatched type",
+				[]byte(`{"name":0}`),
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.JSONOf[jsonOfPayload]
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.JSONOf[jsonOfPayload]
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewJSONOf(jsonOfPayload{}, false),
+			},
+			{
+				"string: JSON null",
+				`null`,
+				nullable.NewJSONOf(jsonOfPayload{}, true),
+			},
+			{
+				"[]byte: object",
+				[]byte(`{"name":"m0t0k1ch1","tags":["a"]}`),
+				nullable.NewJSONOf(jsonOfPayload{Name: "m0t0k1ch1", Tags: []string{"a"}}, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.JSONOf[jsonOfPayload]
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+
+	t.Run("success: replaces the value", func(t *testing.T) {
+		n := nullable.NewJSONOf(jsonOfPayload{Name: "m0t0k1ch1", Tags: []string{"a"}}, true)
+		require.NoError(t, n.Scan(`{"tags":["b"]}`))
+
+		require.Equal(t, nullable.NewJSONOf(jsonOfPayload{Tags: []string{"b"}}, true), n)
+	})
+}
+
+func TestJSONOf_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.JSONOf[jsonOfPayload]
+			want []byte
+		}{
+			{
+				"SQL NULL",
+				nullable.NewJSONOf(jsonOfPayload{}, false),
+				[]byte(`null`),
+			},
+			{
+				"non-zero",
+				nullable.NewJSONOf(jsonOfPayload{Name: "m0t0k1ch1", Tags: []string{"a"}}, true),
+				[]byte(`{"name":"m0t0k1ch1","tags":["a"]}`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestJSONOf_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"boolean",
+				[]byte(`true`),
+				"invalid source",
+			},
+			{
+				"mismatched type",
+				[]byte(`{"tags":"a"}`),
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.JSONOf[jsonOfPayload]
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.JSONOf[jsonOfPayload]
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewJSONOf(jsonOfPayload{}, false),
+			},
+			{
+				"object",
+				[]byte(`{"name":"m0t0k1ch1","tags":["a"]}`),
+				nullable.NewJSONOf(jsonOfPayload{Name: "m0t0k1ch1", Tags: []string{"a"}}, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.JSONOf[jsonOfPayload]
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestJSONOf_Generate(t *testing.T) {
+	t.Run("generator", func(t *testing.T) {
+		ns := generate[nullable.JSONOf[nullable.Int64]](t, 1000)
+
+		tcs := []struct {
+			name string
+			want nullable.JSONOf[nullable.Int64]
+		}{
+			{
+				"null",
+				nullable.NewJSONOf(nullable.NewInt64(0, false), false),
+			},
+			{
+				"zero",
+				nullable.NewJSONOf(nullable.NewInt64(0, true), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Contains(t, ns, tc.want)
+			})
+		}
+	})
+
+	t.Run("non-generator", func(t *testing.T) {
+		ns := generate[nullable.JSONOf[jsonOfPayload]](t, 100)
+
+		require.Contains(t, ns, nullable.NewJSONOf(jsonOfPayload{}, false))
+		require.True(t, slices.ContainsFunc(ns, func(n nullable.JSONOf[jsonOfPayload]) bool {
+			return n.Valid && n.V.Name != ""
+		}))
+
+		for _, n := range ns {
+			b, err := json.Marshal(n)
+			require.NoError(t, err)
+
+			var got nullable.JSONOf[jsonOfPayload]
+			require.NoError(t, json.Unmarshal(b, &got))
+			require.Equal(t, n, got)
+		}
+	})
+
+	t.Run("ungeneratable", func(t *testing.T) {
+		ns := generate[nullable.JSONOf[any]](t, 100)
+
+		for _, n := range ns {
+			require.Equal(t, nullable.NewJSONOf[any](nil, false), n)
+		}
+	})
+}
+
+func FuzzJSONOf_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.JSONOf[jsonOfPayload]](f,
+		[]byte(""),
+		[]byte("null"),
+		[]byte(`{"name":"m0t0k1ch1","tags":["a"]}`),
+		[]byte(`{"name":0}`),
+	)
+}
+
+func FuzzJSONOf_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.JSONOf[jsonOfPayload]](f,
+		[]byte(`true`),
+		[]byte(`null`),
+		[]byte(`{}`),
+		[]byte(`{"name":"m0t0k1ch1","tags":["a"]}`),
+	)
+}
@@ -0,0 +1,399 @@
+// Package nullablecheck defines an Analyzer that reports reads of nullable payload fields
+// that are not guarded by a validity check.
+//
+// Reading n.Int64 or n.EthAddress without checking n.Valid silently yields the zero value
+// when n is null. The analyzer reports such reads unless they are dominated by a check in
+// one of the following forms, where the guarded expression is the same path of
+// identifiers and field selectors as the one being read:
+//
+//	if n.Valid { ... n.Int64 ... }
+//	if !n.Valid { ... } else { ... n.Int64 ... }
+//	if !n.Valid { return }
+//	... n.Int64 ...
+//	n.Valid && n.Int64 > 0
+//	!n.Valid || n.Int64 > 0
+//	switch { case n.Valid: ... n.Int64 ... }
+//
+// Writes to payload fields and taking their address are not reported.
+package nullablecheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/m0t0k1ch1-go/nullable/v3/internal/typeutil"
+)
+
+const doc = `report reads of nullable payload fields without a Valid check
+
+The nullablecheck analyzer reports selector expressions such as n.Int64 or
+n.EthAddress, where n is a nullable type, that are not dominated by a check
+of n.Valid. Such reads silently yield the zero value when n is null.`
+
+// Analyzer reports reads of nullable payload fields that are not guarded by a validity check.
+var Analyzer = &analysis.Analyzer{
+	Name:     "nullablecheck",
+	Doc:      doc,
+	URL:      "https://pkg.go.dev/github.com/m0t0k1ch1-go/nullable/v3/nullablecheck",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	// The nullable package itself is where payloads are read on purpose.
+	if pass.Pkg.Path() == typeutil.NullablePkgPath {
+		return nil, nil
+	}
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	generated := map[*token.File]bool{}
+	for _, f := range pass.Files {
+		if ast.IsGenerated(f) {
+			generated[pass.Fset.File(f.Pos())] = true
+		}
+	}
+
+	insp.WithStack([]ast.Node{(*ast.SelectorExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		sel := n.(*ast.SelectorExpr)
+		if generated[pass.Fset.File(sel.Pos())] || !isPayloadRead(pass.TypesInfo, sel, stack) {
+			return true
+		}
+
+		path, ok := pathOf(pass.TypesInfo, sel.X)
+		if !ok {
+			// Values such as f().Int64 cannot be guarded by a check.
+			reportUnguarded(pass, sel, "", stack)
+			return true
+		}
+
+		if !isGuarded(pass.TypesInfo, path, stack) {
+			reportUnguarded(pass, sel, types.ExprString(sel.X), stack)
+		}
+
+		return true
+	})
+
+	return nil, nil
+}
+
+// isPayloadRead reports whether sel reads a payload field of a nullable value.
+func isPayloadRead(info *types.Info, sel *ast.SelectorExpr, stack []ast.Node) bool {
+	selection, ok := info.Selections[sel]
+	if !ok || selection.Kind() != types.FieldVal {
+		return false
+	}
+
+	field := selection.Obj().(*types.Var)
+	if field.Name() == "Valid" || field.Embedded() {
+		return false
+	}
+
+	recv := selection.Recv()
+	if p, ok := recv.Underlying().(*types.Pointer); ok {
+		recv = p.Elem()
+	}
+	if !typeutil.IsNullable(recv) || !hasValidField(recv) {
+		return false
+	}
+
+	// Writes, including to elements or fields of the payload, and address-taking are not reads.
+	var expr ast.Expr = sel
+	i := len(stack) - 2
+	for ; i > 0; i-- {
+		switch p := stack[i].(type) {
+		case *ast.ParenExpr:
+			expr = p
+			continue
+		case *ast.IndexExpr:
+			if p.X == expr {
+				expr = p
+				continue
+			}
+		case *ast.SelectorExpr:
+			if p.X == expr {
+				expr = p
+				continue
+			}
+		}
+		break
+	}
+
+	switch p := stack[i].(type) {
+	case *ast.AssignStmt:
+		if p.Tok == token.ASSIGN && slices.Contains(p.Lhs, expr) {
+			return false
+		}
+	case *ast.UnaryExpr:
+		if p.Op == token.AND {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasValidField(t types.Type) bool {
+	obj, _, _ := types.LookupFieldOrMethod(t, false, nil, "Valid")
+	v, ok := obj.(*types.Var)
+	if !ok || !v.IsField() {
+		return false
+	}
+
+	b, ok := v.Type().Underlying().(*types.Basic)
+
+	return ok && b.Kind() == types.Bool
+}
+
+// pathOf returns a key identifying expr if it is a path of identifiers and field selectors.
+func pathOf(info *types.Info, expr ast.Expr) (string, bool) {
+	switch e := ast.Unparen(expr).(type) {
+
+	case *ast.Ident:
+		obj := info.Uses[e]
+		if obj == nil {
+			obj = info.Defs[e]
+		}
+		if _, ok := obj.(*types.Var); !ok {
+			return "", false
+		}
+
+		return objectKey(obj), true
+
+	case *ast.SelectorExpr:
+		if selection, ok := info.Selections[e]; ok && selection.Kind() == types.FieldVal {
+			base, ok := pathOf(info, e.X)
+			if !ok {
+				return "", false
+			}
+
+			return base + "." + e.Sel.Name, true
+		}
+
+		// A package-level variable such as pkg.Var.
+		if obj, ok := info.Uses[e.Sel].(*types.Var); ok {
+			return objectKey(obj), true
+		}
+
+	case *ast.StarExpr:
+		return pathOf(info, e.X)
+	}
+
+	return "", false
+}
+
+func objectKey(obj types.Object) string {
+	return obj.Name() + "@" + strconv.Itoa(int(obj.Pos()))
+}
+
+// isGuarded reports whether the innermost node of stack is dominated by a validity check of path.
+func isGuarded(info *types.Info, path string, stack []ast.Node) bool {
+	for i := len(stack) - 1; i > 0; i-- {
+		child, parent := stack[i], stack[i-1]
+
+		switch p := parent.(type) {
+
+		case *ast.FuncDecl:
+			return false
+
+		case *ast.IfStmt:
+			if child == p.Body && impliesValid(info, path, p.Cond, true) {
+				return true
+			}
+			if child == p.Else && impliesValid(info, path, p.Cond, false) {
+				return true
+			}
+
+		case *ast.BinaryExpr:
+			if child != p.Y {
+				continue
+			}
+			if p.Op == token.LAND && impliesValid(info, path, p.X, true) {
+				return true
+			}
+			if p.Op == token.LOR && impliesValid(info, path, p.X, false) {
+				return true
+			}
+
+		case *ast.CaseClause:
+			if i >= 3 {
+				if sw, ok := stack[i-3].(*ast.SwitchStmt); ok && sw.Tag == nil {
+					for _, expr := range p.List {
+						if impliesValid(info, path, expr, true) {
+							return true
+						}
+					}
+				}
+			}
+			if guardedByEarlyExit(info, path, p.Body, child) {
+				return true
+			}
+
+		case *ast.CommClause:
+			if guardedByEarlyExit(info, path, p.Body, child) {
+				return true
+			}
+
+		case *ast.BlockStmt:
+			if guardedByEarlyExit(info, path, p.List, child) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// guardedByEarlyExit reports whether a statement preceding child in stmts is
+// an if statement that leaves the block unless path is valid.
+func guardedByEarlyExit(info *types.Info, path string, stmts []ast.Stmt, child ast.Node) bool {
+	for _, stmt := range stmts {
+		if stmt == child {
+			return false
+		}
+
+		ifStmt, ok := stmt.(*ast.IfStmt)
+		if !ok || ifStmt.Init != nil || ifStmt.Else != nil {
+			continue
+		}
+
+		if impliesValid(info, path, ifStmt.Cond, false) && terminates(ifStmt.Body) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// impliesValid reports whether cond evaluating to want implies that path is valid.
+func impliesValid(info *types.Info, path string, cond ast.Expr, want bool) bool {
+	switch c := ast.Unparen(cond).(type) {
+
+	case *ast.UnaryExpr:
+		if c.Op == token.NOT {
+			return impliesValid(info, path, c.X, !want)
+		}
+
+	case *ast.BinaryExpr:
+		switch c.Op {
+		case token.LAND:
+			if want {
+				return impliesValid(info, path, c.X, true) || impliesValid(info, path, c.Y, true)
+			}
+		case token.LOR:
+			if !want {
+				return impliesValid(info, path, c.X, false) || impliesValid(info, path, c.Y, false)
+			}
+		}
+
+	case *ast.SelectorExpr:
+		if c.Sel.Name != "Valid" {
+			return false
+		}
+		if p, ok := pathOf(info, c.X); ok && p == path {
+			return want
+		}
+	}
+
+	return false
+}
+
+// terminates reports whether the block always leaves the enclosing block.
+func terminates(block *ast.BlockStmt) bool {
+	if len(block.List) == 0 {
+		return false
+	}
+
+	switch s := block.List[len(block.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := s.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		if id, ok := call.Fun.(*ast.Ident); ok && id.Name == "panic" {
+			return true
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok {
+				switch x.Name + "." + sel.Sel.Name {
+				case "log.Fatal", "log.Fatalf", "log.Fatalln", "log.Panic", "log.Panicf", "log.Panicln", "os.Exit":
+					return true
+				}
+			}
+			switch sel.Sel.Name {
+			case "Fatal", "Fatalf", "FailNow", "Skip", "Skipf", "SkipNow":
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func reportUnguarded(pass *analysis.Pass, sel *ast.SelectorExpr, x string, stack []ast.Node) {
+	diag := analysis.Diagnostic{
+		Pos:     sel.Pos(),
+		End:     sel.End(),
+		Message: "read of nullable payload " + types.ExprString(sel) + " without checking Valid",
+	}
+
+	if x != "" {
+		if stmt := wrappableStmt(stack); stmt != nil {
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message: "Wrap in if " + x + ".Valid",
+				TextEdits: []analysis.TextEdit{
+					{Pos: stmt.Pos(), End: stmt.Pos(), NewText: []byte("if " + x + ".Valid {\n")},
+					{Pos: stmt.End(), End: stmt.End(), NewText: []byte("\n}")},
+				},
+			}}
+		}
+	}
+
+	pass.Report(diag)
+}
+
+// wrappableStmt returns the innermost statement enclosing the top of stack
+// if wrapping it in an if statement keeps the code valid.
+func wrappableStmt(stack []ast.Node) ast.Stmt {
+	for i := len(stack) - 1; i > 0; i-- {
+		stmt, ok := stack[i].(ast.Stmt)
+		if !ok {
+			if _, ok := stack[i].(*ast.FuncLit); ok {
+				return nil
+			}
+			continue
+		}
+
+		switch stack[i-1].(type) {
+		case *ast.BlockStmt, *ast.CaseClause, *ast.CommClause:
+		default:
+			return nil
+		}
+
+		switch s := stmt.(type) {
+		case *ast.ExprStmt, *ast.IncDecStmt, *ast.SendStmt:
+			return s
+		case *ast.AssignStmt:
+			if s.Tok != token.DEFINE {
+				return s
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
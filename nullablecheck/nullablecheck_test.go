@@ -0,0 +1,13 @@
+package nullablecheck_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/m0t0k1ch1-go/nullable/v3/nullablecheck"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), nullablecheck.Analyzer, "a")
+}
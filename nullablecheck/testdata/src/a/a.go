@@ -0,0 +1,83 @@
+package a
+
+import (
+	"fmt"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+)
+
+type User struct {
+	Age    nullable.Int64
+	Wallet nullable.EthAddress
+}
+
+func unguarded(n nullable.Int64, u *User) {
+	fmt.Println(n.Int64)             // want `read of nullable payload n.Int64 without checking Valid`
+	fmt.Println(u.Wallet.EthAddress) // want `read of nullable payload u.Wallet.EthAddress without checking Valid`
+	x := n.Int64                     // want `read of nullable payload n.Int64 without checking Valid`
+	_ = x
+	_ = get().Int64 // want `read of nullable payload get\(\).Int64 without checking Valid`
+}
+
+func wrongGuard(n, m nullable.Int64) {
+	if m.Valid {
+		fmt.Println(n.Int64) // want `read of nullable payload n.Int64 without checking Valid`
+	}
+	if !n.Valid {
+		fmt.Println(n.Int64) // want `read of nullable payload n.Int64 without checking Valid`
+	}
+	if n.Valid || m.Valid {
+		fmt.Println(n.Int64) // want `read of nullable payload n.Int64 without checking Valid`
+	}
+}
+
+func guarded(n nullable.Int64, u *User) int64 {
+	if n.Valid {
+		fmt.Println(n.Int64)
+	}
+	if !n.Valid {
+		fmt.Println("null")
+	} else {
+		fmt.Println(n.Int64)
+	}
+	if u.Age.Valid && u.Age.Int64 > 0 {
+		fmt.Println(u.Age.Int64)
+	}
+	if u.Wallet.Valid {
+		fmt.Println(u.Wallet.EthAddress)
+	}
+	_ = !n.Valid || n.Int64 > 0
+	switch {
+	case n.Valid:
+		fmt.Println(n.Int64)
+	}
+	if !n.Valid || n.Int64 < 0 {
+		return 0
+	}
+	f := func() int64 {
+		return n.Int64
+	}
+	return n.Int64 + f()
+}
+
+func guardedLoop(ns []nullable.EthAddress) {
+	for _, n := range ns {
+		if !n.Valid {
+			continue
+		}
+		fmt.Println(n.EthAddress)
+	}
+}
+
+func writes(n *nullable.Int64, u *User) {
+	n.Int64 = 1
+	u.Wallet.EthAddress[0] = 1
+	scan(&n.Int64)
+	n.Valid = true
+}
+
+func get() nullable.Int64 {
+	return nullable.Int64{}
+}
+
+func scan(p *int64) {}
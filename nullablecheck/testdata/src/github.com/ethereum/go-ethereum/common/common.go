@@ -0,0 +1,3 @@
+package common
+
+type Address [20]byte
@@ -0,0 +1,32 @@
+package nullable
+
+import (
+	"database/sql"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type Int64 struct {
+	sql.NullInt64
+}
+
+func NewInt64(i int64, valid bool) Int64 {
+	return Int64{
+		sql.NullInt64{
+			Int64: i,
+			Valid: valid,
+		},
+	}
+}
+
+type EthAddress struct {
+	EthAddress ethcommon.Address
+	Valid      bool
+}
+
+func NewEthAddress(address ethcommon.Address, valid bool) EthAddress {
+	return EthAddress{
+		EthAddress: address,
+		Valid:      valid,
+	}
+}
@@ -0,0 +1,160 @@
+package nullabletest
+
+import (
+	"bytes"
+	"encoding"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+)
+
+// Source is the set of Scan source types a fuzz target can generate.
+type Source interface {
+	[]byte | string | int64 | uint64 | float64 | bool
+}
+
+// FuzzScan fuzzes Scan of the nullable type T with sources of type S, seeded with seeds.
+//
+// Scan must not panic, and any value it accepts must round-trip through Value and Scan.
+func FuzzScan[T Nullable, PT NullablePtr[T], S Source](f *testing.F, seeds ...S) {
+	f.Helper()
+
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, src S) {
+		var n T
+		if err := PT(&n).Scan(src); err != nil {
+			return
+		}
+
+		v, err := n.Value()
+		require.NoError(t, err, "Value of a value scanned from %#v", src)
+
+		var n2 T
+		require.NoError(t, PT(&n2).Scan(v), "Scan of %#v, returned by Value of a value scanned from %#v", v, src)
+
+		v2, err := n2.Value()
+		require.NoError(t, err)
+		require.True(t, valueEqual(v, v2), "got %#v, want %#v (source: %#v)", v2, v, src)
+	})
+}
+
+// FuzzJSON fuzzes UnmarshalJSON of the nullable type T, seeded with seeds.
+//
+// UnmarshalJSON must not panic, and any value it accepts must marshal to JSON that
+// unmarshals back to an equal value and marshals to the same JSON again.
+func FuzzJSON[T Nullable, PT NullablePtr[T]](f *testing.F, seeds ...[]byte) {
+	f.Helper()
+
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		var n T
+		if err := PT(&n).UnmarshalJSON(b); err != nil {
+			return
+		}
+
+		b1, err := n.MarshalJSON()
+		require.NoError(t, err, "MarshalJSON of a value unmarshaled from %q", b)
+
+		var n2 T
+		require.NoError(t, PT(&n2).UnmarshalJSON(b1), "UnmarshalJSON of %s, marshaled from a value unmarshaled from %q", b1, b)
+
+		b2, err := n2.MarshalJSON()
+		require.NoError(t, err)
+		require.Equal(t, string(b1), string(b2), "input: %q", b)
+		require.True(t, equal(t, n, n2), "got %v, want %v (input: %q)", n2, n, b)
+	})
+}
+
+// FuzzText fuzzes UnmarshalText of the nullable type T, seeded with seeds.
+// T must implement encoding.TextMarshaler, and *T encoding.TextUnmarshaler.
+//
+// UnmarshalText must not panic, and any value it accepts must round-trip
+// through MarshalText and UnmarshalText.
+func FuzzText[T Nullable, PT NullablePtr[T]](f *testing.F, seeds ...[]byte) {
+	f.Helper()
+
+	if _, ok := any(*new(T)).(encoding.TextMarshaler); !ok {
+		f.Fatalf("%T does not implement encoding.TextMarshaler", *new(T))
+	}
+	if _, ok := any(PT(new(T))).(encoding.TextUnmarshaler); !ok {
+		f.Fatalf("%T does not implement encoding.TextUnmarshaler", PT(new(T)))
+	}
+
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		var n T
+		if err := any(PT(&n)).(encoding.TextUnmarshaler).UnmarshalText(b); err != nil {
+			return
+		}
+
+		b1, err := any(n).(encoding.TextMarshaler).MarshalText()
+		require.NoError(t, err, "MarshalText of a value unmarshaled from %q", b)
+
+		var n2 T
+		require.NoError(t, any(PT(&n2)).(encoding.TextUnmarshaler).UnmarshalText(b1), "UnmarshalText of %q, marshaled from a value unmarshaled from %q", b1, b)
+		require.True(t, equal(t, n, n2), "got %v, want %v (input: %q)", n2, n, b)
+	})
+}
+
+// FuzzYAML fuzzes YAML decoding of the nullable type T, seeded with seeds.
+//
+// Decoding must not panic, and any value it accepts must round-trip through YAML.
+func FuzzYAML[T Nullable, PT NullablePtr[T]](f *testing.F, seeds ...[]byte) {
+	f.Helper()
+
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		var n T
+		if err := yaml.Unmarshal(b, PT(&n)); err != nil {
+			return
+		}
+
+		b1, err := yaml.Marshal(n)
+		require.NoError(t, err, "YAML encoding of a value decoded from %q", b)
+
+		var n2 T
+		require.NoError(t, yaml.Unmarshal(b1, PT(&n2)), "YAML decoding of %q, encoded from a value decoded from %q", b1, b)
+		require.True(t, equal(t, n, n2), "got %v, want %v (input: %q)", n2, n, b)
+	})
+}
+
+// equal reports whether a and b have the same driver.Value and JSON encoding.
+func equal[T Nullable](t *testing.T, a, b T) bool {
+	av, err := a.Value()
+	require.NoError(t, err)
+	bv, err := b.Value()
+	require.NoError(t, err)
+
+	aj, err := a.MarshalJSON()
+	require.NoError(t, err)
+	bj, err := b.MarshalJSON()
+	require.NoError(t, err)
+
+	return valueEqual(av, bv) && bytes.Equal(aj, bj)
+}
+
+// valueEqual reports whether a and b are deeply equal, treating NaNs as equal.
+func valueEqual(a, b any) bool {
+	if af, ok := a.(float64); ok {
+		if bf, ok := b.(float64); ok && math.IsNaN(af) && math.IsNaN(bf) {
+			return true
+		}
+	}
+
+	return reflect.DeepEqual(a, b)
+}
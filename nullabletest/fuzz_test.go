@@ -0,0 +1,19 @@
+package nullabletest_test
+
+import (
+	"testing"
+
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func FuzzScan(f *testing.F) {
+	nullabletest.FuzzScan[Code](f, "", "A", "a", "NULL")
+}
+
+func FuzzJSON(f *testing.F) {
+	nullabletest.FuzzJSON[Code](f, []byte(`null`), []byte(`0`), []byte(`""`), []byte(`"A"`), []byte(`"a"`))
+}
+
+func FuzzText(f *testing.F) {
+	nullabletest.FuzzText[Code](f, []byte(""), []byte("A"), []byte("a"))
+}
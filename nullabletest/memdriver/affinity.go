@@ -0,0 +1,352 @@
+package memdriver
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kind represents the kind of value a column stores and returns to Scan.
+type Kind int
+
+const (
+	// KindInt64 stores integers and returns them as int64.
+	KindInt64 Kind = iota
+
+	// KindUnsigned stores non-negative integers up to math.MaxUint64 and returns them
+	// as int64, or as a decimal []byte if they exceed math.MaxInt64.
+	KindUnsigned
+
+	// KindFloat64 stores numbers and returns them as float64.
+	KindFloat64
+
+	// KindBool stores booleans and returns them as bool.
+	KindBool
+
+	// KindString stores text and returns it as string.
+	KindString
+
+	// KindText stores text and returns it as []byte.
+	KindText
+
+	// KindNumeric stores decimal numbers and returns their text as []byte.
+	KindNumeric
+
+	// KindBytes stores binary data and returns it as []byte.
+	KindBytes
+
+	// KindTime stores times and returns them as time.Time.
+	KindTime
+)
+
+// Rule maps the declared column types containing Contains (case-insensitively) to Kind.
+type Rule struct {
+	Contains string
+	Kind     Kind
+}
+
+// Affinity determines how columns store the values bound to them and which
+// Go types they return to Scan, based on their declared types.
+type Affinity struct {
+	// Name is the name of the affinity, used in error messages.
+	Name string
+
+	// Rules are checked in order; the first matching rule wins.
+	Rules []Rule
+
+	// Default is the kind of the columns no rule matches.
+	Default Kind
+
+	// Dynamic makes columns store values they cannot convert as they are,
+	// as SQLite does, instead of rejecting them.
+	Dynamic bool
+}
+
+// Postgres mimics PostgreSQL through database/sql drivers such as lib/pq,
+// which return NUMERIC and unknown types as text []byte.
+var Postgres = Affinity{
+	Name: "postgres",
+	Rules: []Rule{
+		{"INTERVAL", KindText},
+		{"RANGE", KindText},
+		{"POINT", KindText},
+		{"BIGINT", KindInt64},
+		{"SMALLINT", KindInt64},
+		{"INT", KindInt64},
+		{"SERIAL", KindInt64},
+		{"NUMERIC", KindNumeric},
+		{"DECIMAL", KindNumeric},
+		{"DOUBLE", KindFloat64},
+		{"REAL", KindFloat64},
+		{"FLOAT", KindFloat64},
+		{"BOOL", KindBool},
+		{"BYTEA", KindBytes},
+		{"TIMESTAMP", KindTime},
+		{"DATE", KindTime},
+		{"CHAR", KindString},
+		{"TEXT", KindString},
+	},
+	Default: KindText,
+}
+
+// MySQL mimics MySQL through go-sql-driver/mysql with parseTime=true,
+// which returns text columns as []byte and BOOLEAN (TINYINT(1)) as int64.
+var MySQL = Affinity{
+	Name: "mysql",
+	Rules: []Rule{
+		{"UNSIGNED", KindUnsigned},
+		{"INT", KindInt64},
+		{"BOOL", KindInt64},
+		{"DECIMAL", KindNumeric},
+		{"NUMERIC", KindNumeric},
+		{"DOUBLE", KindFloat64},
+		{"FLOAT", KindFloat64},
+		{"REAL", KindFloat64},
+		{"BINARY", KindBytes},
+		{"BLOB", KindBytes},
+		{"DATE", KindTime},
+		{"TIMESTAMP", KindTime},
+	},
+	Default: KindText,
+}
+
+// SQLite mimics SQLite's type affinity rules through drivers such as mattn/go-sqlite3.
+// Values a column cannot convert are stored as they are.
+var SQLite = Affinity{
+	Name: "sqlite",
+	Rules: []Rule{
+		{"INT", KindInt64},
+		{"CHAR", KindString},
+		{"CLOB", KindString},
+		{"TEXT", KindString},
+		{"BLOB", KindBytes},
+		{"REAL", KindFloat64},
+		{"FLOA", KindFloat64},
+		{"DOUB", KindFloat64},
+		{"TIMESTAMP", KindTime},
+		{"DATETIME", KindTime},
+		{"DATE", KindTime},
+		{"BOOL", KindInt64},
+	},
+	Default: KindNumeric,
+	Dynamic: true,
+}
+
+func (a Affinity) kindOf(declType string) Kind {
+	declType = strings.ToUpper(declType)
+	for _, r := range a.Rules {
+		if strings.Contains(declType, strings.ToUpper(r.Contains)) {
+			return r.Kind
+		}
+	}
+
+	return a.Default
+}
+
+// store converts v, bound to a column of kind k, to the value the column returns.
+func (a Affinity) store(k Kind, v driver.Value) (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	sv, err := convert(k, v)
+	if err != nil {
+		if !a.Dynamic {
+			return nil, err
+		}
+
+		switch v := v.(type) {
+		case uint64:
+			return nil, err
+		case []byte:
+			return bytes.Clone(v), nil
+		default:
+			return v, nil
+		}
+	}
+
+	return sv, nil
+}
+
+func convert(k Kind, v driver.Value) (driver.Value, error) {
+	switch k {
+
+	case KindInt64:
+		switch v := v.(type) {
+		case int64:
+			return v, nil
+		case uint64:
+			if v > math.MaxInt64 {
+				return nil, fmt.Errorf("value out of range: %d", v)
+			}
+			return int64(v), nil
+		case bool:
+			if v {
+				return int64(1), nil
+			}
+			return int64(0), nil
+		case string:
+			return strconv.ParseInt(v, 10, 64)
+		case []byte:
+			return strconv.ParseInt(string(v), 10, 64)
+		}
+
+	case KindUnsigned:
+		var u uint64
+		switch v := v.(type) {
+		case int64:
+			if v < 0 {
+				return nil, fmt.Errorf("value out of range: %d", v)
+			}
+			u = uint64(v)
+		case uint64:
+			u = v
+		case string, []byte:
+			var err error
+			if u, err = strconv.ParseUint(asString(v), 10, 64); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, unsupported(k, v)
+		}
+		if u > math.MaxInt64 {
+			return []byte(strconv.FormatUint(u, 10)), nil
+		}
+		return int64(u), nil
+
+	case KindFloat64:
+		switch v := v.(type) {
+		case float64:
+			return v, nil
+		case int64:
+			return float64(v), nil
+		case uint64:
+			return float64(v), nil
+		case string, []byte:
+			return strconv.ParseFloat(asString(v), 64)
+		}
+
+	case KindBool:
+		switch v := v.(type) {
+		case bool:
+			return v, nil
+		case int64:
+			if v == 0 || v == 1 {
+				return v == 1, nil
+			}
+		case string, []byte:
+			return strconv.ParseBool(asString(v))
+		}
+
+	case KindString, KindText:
+		var s string
+		switch v := v.(type) {
+		case string:
+			s = v
+		case []byte:
+			s = string(v)
+		case int64:
+			s = strconv.FormatInt(v, 10)
+		case uint64:
+			s = strconv.FormatUint(v, 10)
+		case float64:
+			s = strconv.FormatFloat(v, 'g', -1, 64)
+		case bool:
+			s = strconv.FormatBool(v)
+		case time.Time:
+			s = v.Format(time.RFC3339Nano)
+		default:
+			return nil, unsupported(k, v)
+		}
+		if k == KindText {
+			return []byte(s), nil
+		}
+		return s, nil
+
+	case KindNumeric:
+		var s string
+		switch v := v.(type) {
+		case int64:
+			s = strconv.FormatInt(v, 10)
+		case uint64:
+			s = strconv.FormatUint(v, 10)
+		case float64:
+			s = strconv.FormatFloat(v, 'f', -1, 64)
+		case string, []byte:
+			s = asString(v)
+			if _, ok := new(big.Rat).SetString(s); !ok || strings.ContainsAny(s, "/eE") {
+				return nil, fmt.Errorf("invalid input for numeric: %q", s)
+			}
+		default:
+			return nil, unsupported(k, v)
+		}
+		return []byte(s), nil
+
+	case KindBytes:
+		switch v := v.(type) {
+		case []byte:
+			return bytes.Clone(v), nil
+		case string:
+			return []byte(v), nil
+		}
+
+	case KindTime:
+		switch v := v.(type) {
+		case time.Time:
+			return v, nil
+		case string, []byte:
+			return parseTime(asString(v))
+		}
+	}
+
+	return nil, unsupported(k, v)
+}
+
+// timeLayouts are the layouts a time column parses text in.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999",
+	time.DateOnly,
+}
+
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid input for time: %q", s)
+}
+
+func asString(v driver.Value) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	}
+
+	return fmt.Sprint(v)
+}
+
+var kindNames = map[Kind]string{
+	KindInt64:    "integer",
+	KindUnsigned: "unsigned integer",
+	KindFloat64:  "float",
+	KindBool:     "boolean",
+	KindString:   "string",
+	KindText:     "text",
+	KindNumeric:  "numeric",
+	KindBytes:    "binary",
+	KindTime:     "time",
+}
+
+func unsupported(k Kind, v driver.Value) error {
+	return fmt.Errorf("cannot store %T in a %s column", v, kindNames[k])
+}
@@ -0,0 +1,156 @@
+package memdriver_test
+
+import (
+	"database/sql/driver"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest/memdriver"
+)
+
+func TestAffinity(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tm := time.Date(2009, 1, 3, 18, 15, 5, 0, time.UTC)
+
+		tcs := []struct {
+			name     string
+			affinity memdriver.Affinity
+			colType  string
+			in       any
+			want     driver.Value
+		}{
+			{
+				"postgres: BIGINT",
+				memdriver.Postgres,
+				"BIGINT",
+				int64(1),
+				int64(1),
+			},
+			{
+				"postgres: NUMERIC: uint64",
+				memdriver.Postgres,
+				"NUMERIC(20, 0)",
+				uint64(math.MaxUint64),
+				[]byte("18446744073709551615"),
+			},
+			{
+				"postgres: NUMERIC: string",
+				memdriver.Postgres,
+				"numeric",
+				"-1.5",
+				[]byte("-1.5"),
+			},
+			{
+				"postgres: BOOLEAN",
+				memdriver.Postgres,
+				"BOOLEAN",
+				true,
+				true,
+			},
+			{
+				"postgres: TEXT",
+				memdriver.Postgres,
+				"TEXT",
+				[]byte("text"),
+				"text",
+			},
+			{
+				"postgres: TIMESTAMPTZ",
+				memdriver.Postgres,
+				"TIMESTAMPTZ",
+				tm,
+				tm,
+			},
+			{
+				"postgres: JSONB",
+				memdriver.Postgres,
+				"JSONB",
+				`{}`,
+				[]byte(`{}`),
+			},
+			{
+				"mysql: BOOLEAN",
+				memdriver.MySQL,
+				"BOOLEAN",
+				true,
+				int64(1),
+			},
+			{
+				"mysql: BIGINT UNSIGNED: small",
+				memdriver.MySQL,
+				"BIGINT UNSIGNED",
+				uint64(1),
+				int64(1),
+			},
+			{
+				"mysql: BIGINT UNSIGNED: large",
+				memdriver.MySQL,
+				"BIGINT UNSIGNED",
+				uint64(math.MaxUint64),
+				[]byte("18446744073709551615"),
+			},
+			{
+				"mysql: VARCHAR",
+				memdriver.MySQL,
+				"VARCHAR(255)",
+				"text",
+				[]byte("text"),
+			},
+			{
+				"mysql: DATETIME",
+				memdriver.MySQL,
+				"DATETIME",
+				tm,
+				tm,
+			},
+			{
+				"sqlite: INTEGER: string",
+				memdriver.SQLite,
+				"INTEGER",
+				"1",
+				int64(1),
+			},
+			{
+				"sqlite: INTEGER: text is stored as it is",
+				memdriver.SQLite,
+				"INTEGER",
+				"one",
+				"one",
+			},
+			{
+				"sqlite: NUMERIC: binary is stored as it is",
+				memdriver.SQLite,
+				"NUMERIC",
+				[]byte{0xff},
+				[]byte{0xff},
+			},
+			{
+				"sqlite: TEXT",
+				memdriver.SQLite,
+				"TEXT",
+				[]byte("text"),
+				"text",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				db := memdriver.Open(tc.affinity)
+				defer db.Close()
+
+				_, err := db.Exec(`CREATE TABLE t (v ` + tc.colType + `)`)
+				require.NoError(t, err)
+
+				_, err = db.Exec(`INSERT INTO t (v) VALUES (?)`, tc.in)
+				require.NoError(t, err)
+
+				var v any
+				require.NoError(t, db.QueryRow(`SELECT v FROM t`).Scan(&v))
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
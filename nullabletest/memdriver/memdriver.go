@@ -0,0 +1,355 @@
+// Package memdriver provides an in-memory database/sql driver for round-trip testing
+// nullable types without a real database.
+//
+// It understands a small subset of SQL:
+//
+//	CREATE TABLE t (c1 TYPE [NOT NULL], ...)
+//	INSERT INTO t (c1, ...) VALUES (?, ...)[, (?, ...)]
+//	SELECT c1, ... | * FROM t [WHERE c = ?]
+//	DELETE FROM t [WHERE c = ?]
+//
+// Placeholders may be written as ? or $n, and identifiers may be quoted with double quotes or backticks.
+// Values are converted according to an Affinity, which mimics the Go types
+// a particular database and driver return to Scan.
+package memdriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Open returns a *sql.DB backed by a new, empty in-memory database with Affinity a.
+func Open(a Affinity) *sql.DB {
+	return sql.OpenDB(NewConnector(a))
+}
+
+// Connector implements driver.Connector.
+// All connections of a Connector share the same database.
+type Connector struct {
+	affinity Affinity
+
+	mu     sync.Mutex
+	tables map[string]*table
+}
+
+// NewConnector returns a new Connector with an empty database and Affinity a.
+func NewConnector(a Affinity) *Connector {
+	return &Connector{
+		affinity: a,
+		tables:   map[string]*table{},
+	}
+}
+
+// Connect implements driver.Connector.
+func (c *Connector) Connect(context.Context) (driver.Conn, error) {
+	return &conn{c}, nil
+}
+
+// Driver implements driver.Connector.
+func (c *Connector) Driver() driver.Driver {
+	return drv{c}
+}
+
+type drv struct {
+	c *Connector
+}
+
+func (d drv) Open(string) (driver.Conn, error) {
+	return &conn{d.c}, nil
+}
+
+type table struct {
+	columns []column
+	rows    [][]driver.Value
+}
+
+type column struct {
+	name     string
+	declType string
+	kind     Kind
+	notNull  bool
+}
+
+func (t *table) index(name string) (int, error) {
+	for i, col := range t.columns {
+		if strings.EqualFold(col.name, name) {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown column: %s", name)
+}
+
+type conn struct {
+	c *Connector
+}
+
+func (cn *conn) Prepare(query string) (driver.Stmt, error) {
+	q, err := parse(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stmt{cn.c, q}, nil
+}
+
+func (cn *conn) Close() error {
+	return nil
+}
+
+func (cn *conn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+var valuerType = reflect.TypeFor[driver.Valuer]()
+
+// CheckNamedValue implements driver.NamedValueChecker.
+// Unlike the default converter, it accepts uint64 values with the high bit set.
+func (cn *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	v := nv.Value
+	if vr, ok := v.(driver.Valuer); ok {
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() && rv.Type().Elem().Implements(valuerType) {
+			nv.Value = nil
+
+			return nil
+		}
+
+		var err error
+		if v, err = vr.Value(); err != nil {
+			return err
+		}
+	}
+
+	if u, ok := v.(uint64); ok {
+		nv.Value = u
+
+		return nil
+	}
+
+	v, err := driver.DefaultParameterConverter.ConvertValue(v)
+	if err != nil {
+		return err
+	}
+
+	nv.Value = v
+
+	return nil
+}
+
+type stmt struct {
+	c *Connector
+	q *query
+}
+
+func (s *stmt) Close() error {
+	return nil
+}
+
+func (s *stmt) NumInput() int {
+	return s.q.numInput
+}
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	switch s.q.op {
+
+	case opCreate:
+		if _, ok := s.c.tables[s.q.table]; ok {
+			return nil, fmt.Errorf("table already exists: %s", s.q.table)
+		}
+
+		t := &table{}
+		for _, def := range s.q.defs {
+			def.kind = s.c.affinity.kindOf(def.declType)
+			t.columns = append(t.columns, def)
+		}
+		s.c.tables[s.q.table] = t
+
+		return driver.ResultNoRows, nil
+
+	case opInsert:
+		t, err := s.c.table(s.q.table)
+		if err != nil {
+			return nil, err
+		}
+
+		idxs := make([]int, len(s.q.columns))
+		for i, name := range s.q.columns {
+			if idxs[i], err = t.index(name); err != nil {
+				return nil, err
+			}
+		}
+
+		var rows [][]driver.Value
+		for _, tuple := range s.q.tuples {
+			row := make([]driver.Value, len(t.columns))
+			for i, p := range tuple {
+				col := t.columns[idxs[i]]
+
+				v, err := s.c.affinity.store(col.kind, p.value(args))
+				if err != nil {
+					return nil, fmt.Errorf("%s: column %s %s: %w", s.c.affinity.Name, col.name, col.declType, err)
+				}
+
+				row[idxs[i]] = v
+			}
+
+			for i, col := range t.columns {
+				if col.notNull && row[i] == nil {
+					return nil, fmt.Errorf("%s: column %s violates not-null constraint", s.c.affinity.Name, col.name)
+				}
+			}
+
+			rows = append(rows, row)
+		}
+		t.rows = append(t.rows, rows...)
+
+		return driver.RowsAffected(len(rows)), nil
+
+	case opDelete:
+		t, err := s.c.table(s.q.table)
+		if err != nil {
+			return nil, err
+		}
+
+		var kept [][]driver.Value
+		for _, row := range t.rows {
+			ok, err := s.match(t, row, args)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				kept = append(kept, row)
+			}
+		}
+
+		n := len(t.rows) - len(kept)
+		t.rows = kept
+
+		return driver.RowsAffected(n), nil
+	}
+
+	return nil, errors.New("Exec does not support SELECT; use Query")
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.q.op != opSelect {
+		return nil, errors.New("Query supports SELECT only; use Exec")
+	}
+
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	t, err := s.c.table(s.q.table)
+	if err != nil {
+		return nil, err
+	}
+
+	names := s.q.columns
+	if names == nil {
+		for _, col := range t.columns {
+			names = append(names, col.name)
+		}
+	}
+
+	idxs := make([]int, len(names))
+	for i, name := range names {
+		if idxs[i], err = t.index(name); err != nil {
+			return nil, err
+		}
+	}
+
+	r := &rows{columns: names}
+	for _, row := range t.rows {
+		ok, err := s.match(t, row, args)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		vs := make([]driver.Value, len(idxs))
+		for i, idx := range idxs {
+			vs[i] = clone(row[idx])
+		}
+		r.rows = append(r.rows, vs)
+	}
+
+	return r, nil
+}
+
+func (s *stmt) match(t *table, row []driver.Value, args []driver.Value) (bool, error) {
+	if s.q.where == "" {
+		return true, nil
+	}
+
+	idx, err := t.index(s.q.where)
+	if err != nil {
+		return false, err
+	}
+
+	col := t.columns[idx]
+
+	v, err := s.c.affinity.store(col.kind, s.q.whereParam.value(args))
+	if err != nil {
+		return false, fmt.Errorf("%s: column %s %s: %w", s.c.affinity.Name, col.name, col.declType, err)
+	}
+
+	if v == nil || row[idx] == nil {
+		return false, nil
+	}
+
+	return reflect.DeepEqual(v, row[idx]), nil
+}
+
+func (c *Connector) table(name string) (*table, error) {
+	t, ok := c.tables[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown table: %s", name)
+	}
+
+	return t, nil
+}
+
+func clone(v driver.Value) driver.Value {
+	if b, ok := v.([]byte); ok {
+		return bytes.Clone(b)
+	}
+
+	return v
+}
+
+type rows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *rows) Columns() []string {
+	return r.columns
+}
+
+func (r *rows) Close() error {
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+
+	return nil
+}
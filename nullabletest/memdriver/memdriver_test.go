@@ -0,0 +1,542 @@
+package memdriver_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"math/big"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/m0t0k1ch1-go/bigutil/v3"
+	"github.com/m0t0k1ch1-go/sqlutil/v3"
+	"github.com/m0t0k1ch1-go/timeutil/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest/memdriver"
+)
+
+// roundTrip inserts each of in into a column of type colType and selects them back
+// into values of the same type.
+func roundTrip(t *testing.T, a memdriver.Affinity, colType string, in []driver.Valuer) ([]driver.Valuer, error) {
+	t.Helper()
+
+	db := memdriver.Open(a)
+	t.Cleanup(func() { db.Close() })
+
+	_, err := db.Exec(`CREATE TABLE t (v ` + colType + `)`)
+	require.NoError(t, err)
+
+	for _, v := range in {
+		if _, err := db.Exec(`INSERT INTO t (v) VALUES (?)`, v); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := db.Query(`SELECT v FROM t`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var out []driver.Valuer
+	for i := 0; rows.Next(); i++ {
+		dest := reflect.New(reflect.TypeOf(in[i]))
+		if err := rows.Scan(dest.Interface()); err != nil {
+			return nil, err
+		}
+		out = append(out, dest.Elem().Interface().(driver.Valuer))
+	}
+	require.NoError(t, rows.Err())
+
+	return out, nil
+}
+
+func TestOpen(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name     string
+			affinity memdriver.Affinity
+			colType  string
+			in       driver.Valuer
+			want     string
+		}{
+			{
+				"postgres: Uint256: NUMERIC",
+				memdriver.Postgres,
+				"NUMERIC(78, 0)",
+				nullable.NewUint256(bigutil.NewUint256FromUint64(1), true),
+				"postgres: column v NUMERIC(78, 0): invalid input for numeric",
+			},
+			{
+				"postgres: Int64: BOOLEAN",
+				memdriver.Postgres,
+				"BOOLEAN",
+				nullable.NewInt64(2, true),
+				"postgres: column v BOOLEAN: cannot store int64 in a boolean column",
+			},
+			{
+				"postgres: Timestamp: TIMESTAMPTZ",
+				memdriver.Postgres,
+				"TIMESTAMPTZ",
+				nullable.NewTimestamp(timeutil.NewTimestampFromUnix(1), true),
+				"postgres: column v TIMESTAMPTZ: cannot store int64 in a time column",
+			},
+			{
+				"mysql: Uint64: BIGINT",
+				memdriver.MySQL,
+				"BIGINT",
+				nullable.NewUint64(math.MaxUint64, true),
+				"mysql: column v BIGINT: value out of range",
+			},
+			{
+				"sqlite: Uint64: INTEGER",
+				memdriver.SQLite,
+				"INTEGER",
+				nullable.NewUint64(math.MaxUint64, true),
+				"sqlite: column v INTEGER: value out of range",
+			},
+			{
+				"sqlite: NOT NULL",
+				memdriver.SQLite,
+				"TEXT NOT NULL",
+				nullable.NewString("", false),
+				"sqlite: column v violates not-null constraint",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := roundTrip(t, tc.affinity, tc.colType, []driver.Valuer{tc.in})
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		type column struct {
+			affinity memdriver.Affinity
+			colType  string
+		}
+
+		// The column types are those cmd/nullable-ddl generates.
+		tcs := []struct {
+			name    string
+			columns []column
+			in      []driver.Valuer
+		}{
+			{
+				"Addr",
+				[]column{{memdriver.Postgres, "INET"}, {memdriver.MySQL, "VARCHAR(45)"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewAddr(netip.Addr{}, false),
+					nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), true),
+					nullable.NewAddr(netip.MustParseAddr("1::2"), true),
+					nullable.NewAddr(netip.MustParseAddr("2001:db8::1:2:34"), true),
+				},
+			},
+			{
+				"BigInt",
+				[]column{{memdriver.Postgres, "NUMERIC"}, {memdriver.MySQL, "DECIMAL(65, 0)"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewBigInt(nil, false),
+					nullable.NewBigInt(big.NewInt(0), true),
+					nullable.NewBigInt(new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 200)), true),
+				},
+			},
+			{
+				"BigRat",
+				[]column{{memdriver.Postgres, "TEXT"}, {memdriver.MySQL, "TEXT"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewBigRat(nil, false),
+					nullable.NewBigRat(big.NewRat(-1, 40), true),
+					nullable.NewBigRat(big.NewRat(1, 3), true),
+				},
+			},
+			{
+				"Bool",
+				[]column{{memdriver.Postgres, "BOOLEAN"}, {memdriver.MySQL, "BOOLEAN"}, {memdriver.SQLite, "INTEGER"}},
+				[]driver.Valuer{
+					nullable.NewBool(false, false),
+					nullable.NewBool(false, true),
+					nullable.NewBool(true, true),
+				},
+			},
+			{
+				"Bytes",
+				[]column{{memdriver.Postgres, "BYTEA"}, {memdriver.MySQL, "LONGBLOB"}, {memdriver.SQLite, "BLOB"}},
+				[]driver.Valuer{
+					nullable.NewBytes(nil, false),
+					nullable.NewBytes([]byte{}, true),
+					nullable.NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+				},
+			},
+			{
+				"Date",
+				[]column{{memdriver.Postgres, "DATE"}, {memdriver.MySQL, "DATE"}, {memdriver.SQLite, "DATE"}},
+				[]driver.Valuer{
+					nullable.NewDate(0, 0, 0, false),
+					nullable.NewDate(2009, time.January, 3, true),
+				},
+			},
+			{
+				"Decimal",
+				[]column{{memdriver.Postgres, "NUMERIC"}, {memdriver.MySQL, "DECIMAL(65, 30)"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewDecimal(nil, 0, false),
+					nullable.NewDecimal(big.NewInt(-150), 2, true),
+					nullable.NewDecimal(new(big.Int).Lsh(big.NewInt(1), 100), 18, true),
+				},
+			},
+			{
+				"Duration",
+				[]column{{memdriver.Postgres, "BIGINT"}, {memdriver.MySQL, "BIGINT"}, {memdriver.SQLite, "INTEGER"}},
+				[]driver.Valuer{
+					nullable.NewDuration(0, false),
+					nullable.NewDuration(90*time.Minute, true),
+					nullable.NewDuration(math.MinInt64, true),
+				},
+			},
+			{
+				"DurationISO8601",
+				[]column{{memdriver.Postgres, "INTERVAL"}, {memdriver.MySQL, "VARCHAR(32)"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewDurationISO8601(0, false),
+					nullable.NewDurationISO8601(-1500*time.Millisecond, true),
+					nullable.NewDurationISO8601(math.MinInt64, true),
+				},
+			},
+			{
+				"DurationNanoseconds",
+				[]column{{memdriver.Postgres, "BIGINT"}, {memdriver.MySQL, "BIGINT"}, {memdriver.SQLite, "INTEGER"}},
+				[]driver.Valuer{
+					nullable.NewDurationNanoseconds(0, false),
+					nullable.NewDurationNanoseconds(math.MaxInt64, true),
+				},
+			},
+			{
+				"DurationSeconds",
+				[]column{{memdriver.Postgres, "BIGINT"}, {memdriver.MySQL, "BIGINT"}, {memdriver.SQLite, "INTEGER"}},
+				[]driver.Valuer{
+					nullable.NewDurationSeconds(0, false),
+					nullable.NewDurationSeconds(90*time.Minute, true),
+					nullable.NewDurationSeconds(-time.Second, true),
+				},
+			},
+			{
+				"EthAddress",
+				[]column{{memdriver.Postgres, "BYTEA"}, {memdriver.MySQL, "BINARY(20)"}, {memdriver.SQLite, "BLOB"}},
+				[]driver.Valuer{
+					nullable.NewEthAddress(ethcommon.Address{}, false),
+					nullable.NewEthAddress(ethcommon.HexToAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"), true),
+				},
+			},
+			{
+				"EthHash",
+				[]column{{memdriver.Postgres, "BYTEA"}, {memdriver.MySQL, "BINARY(32)"}, {memdriver.SQLite, "BLOB"}},
+				[]driver.Valuer{
+					nullable.NewEthHash(ethcommon.Hash{}, false),
+					nullable.NewEthHash(ethcommon.HexToHash("0x000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"), true),
+				},
+			},
+			{
+				"Float64",
+				[]column{{memdriver.Postgres, "DOUBLE PRECISION"}, {memdriver.MySQL, "DOUBLE"}, {memdriver.SQLite, "REAL"}},
+				[]driver.Valuer{
+					nullable.NewFloat64(0, false),
+					nullable.NewFloat64(-1.5, true),
+					nullable.NewFloat64(math.MaxFloat64, true),
+				},
+			},
+			{
+				"HTTPURL",
+				[]column{{memdriver.Postgres, "TEXT"}, {memdriver.MySQL, "TEXT"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewHTTPURL(sqlutil.HTTPURL{}, false),
+					nullable.NewHTTPURL(sqlutil.MustNewHTTPURLFromString("https://m0t0k1ch1.com"), true),
+				},
+			},
+			{
+				"Int8",
+				[]column{{memdriver.Postgres, "SMALLINT"}, {memdriver.MySQL, "TINYINT"}, {memdriver.SQLite, "INTEGER"}},
+				[]driver.Valuer{
+					nullable.NewInt8(0, false),
+					nullable.NewInt8(math.MinInt8, true),
+					nullable.NewInt8(math.MaxInt8, true),
+				},
+			},
+			{
+				"Int16",
+				[]column{{memdriver.Postgres, "SMALLINT"}, {memdriver.MySQL, "SMALLINT"}, {memdriver.SQLite, "INTEGER"}},
+				[]driver.Valuer{
+					nullable.NewInt16(0, false),
+					nullable.NewInt16(math.MinInt16, true),
+					nullable.NewInt16(math.MaxInt16, true),
+				},
+			},
+			{
+				"Int32",
+				[]column{{memdriver.Postgres, "INTEGER"}, {memdriver.MySQL, "INT"}, {memdriver.SQLite, "INTEGER"}},
+				[]driver.Valuer{
+					nullable.NewInt32(0, false),
+					nullable.NewInt32(math.MinInt32, true),
+					nullable.NewInt32(math.MaxInt32, true),
+				},
+			},
+			{
+				"Int64",
+				[]column{{memdriver.Postgres, "BIGINT"}, {memdriver.MySQL, "BIGINT"}, {memdriver.SQLite, "INTEGER"}},
+				[]driver.Valuer{
+					nullable.NewInt64(0, false),
+					nullable.NewInt64(math.MinInt64, true),
+					nullable.NewInt64(math.MaxInt64, true),
+				},
+			},
+			{
+				"Int256",
+				[]column{{memdriver.Postgres, "BYTEA"}, {memdriver.MySQL, "VARBINARY(32)"}, {memdriver.SQLite, "BLOB"}},
+				[]driver.Valuer{
+					nullable.NewInt256FromInt64(0, false),
+					nullable.NewInt256FromInt64(0, true),
+					nullable.NewInt256FromInt64(math.MinInt64, true),
+				},
+			},
+			{
+				"Int256Numeric",
+				[]column{{memdriver.Postgres, "NUMERIC(78, 0)"}, {memdriver.MySQL, "VARCHAR(78)"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.MustNewInt256Numeric(nil, false),
+					nullable.MustNewInt256Numeric(nil, true),
+					nullable.Int256Numeric(nullable.NewInt256FromInt64(math.MinInt64, true)),
+				},
+			},
+			{
+				"JSON",
+				[]column{{memdriver.Postgres, "JSONB"}, {memdriver.MySQL, "JSON"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewJSON(nil, false),
+					nullable.NewJSON(json.RawMessage(`null`), true),
+					nullable.NewJSON(json.RawMessage(`{"a":[1,true,null]}`), true),
+				},
+			},
+			{
+				"JSONOf",
+				[]column{{memdriver.Postgres, "JSONB"}, {memdriver.MySQL, "JSON"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewJSONOf[map[string]int](nil, false),
+					nullable.NewJSONOf(map[string]int{}, true),
+					nullable.NewJSONOf(map[string]int{"a": 1}, true),
+				},
+			},
+			{
+				"Number",
+				[]column{{memdriver.Postgres, "NUMERIC"}, {memdriver.MySQL, "TEXT"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewNumber("", false),
+					nullable.NewNumber("-0", true),
+					nullable.NewNumber("1.50", true),
+					nullable.NewNumber("12345678901234567890.123", true),
+				},
+			},
+			{
+				"Prefix",
+				[]column{{memdriver.Postgres, "CIDR"}, {memdriver.MySQL, "VARCHAR(49)"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewPrefix(netip.Prefix{}, false),
+					nullable.NewPrefix(netip.MustParsePrefix("10.0.0.0/8"), true),
+					nullable.NewPrefix(netip.MustParsePrefix("2001:db8::/32"), true),
+				},
+			},
+			{
+				"Range",
+				[]column{{memdriver.Postgres, "INT8RANGE"}, {memdriver.MySQL, "TEXT"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.Range[nullable.Int64]{},
+					nullable.NewEmptyRange[nullable.Int64](),
+					nullable.Range[nullable.Int64]{Lower: nullable.NewInt64(1, true), LowerInclusive: true, Valid: true},
+					nullable.Range[nullable.Int64]{Lower: nullable.NewInt64(math.MinInt64, true), Upper: nullable.NewInt64(0, true), LowerInclusive: true, Valid: true},
+				},
+			},
+			{
+				"Range: TSTZRANGE",
+				[]column{{memdriver.Postgres, "TSTZRANGE"}, {memdriver.MySQL, "TEXT"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.Range[nullable.Time]{Lower: nullable.NewTime(time.Date(2009, 1, 3, 18, 15, 5, 123456000, time.UTC), true), LowerInclusive: true, Valid: true},
+				},
+			},
+			{
+				"String",
+				[]column{{memdriver.Postgres, "TEXT"}, {memdriver.MySQL, "TEXT"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewString("", false),
+					nullable.NewString("", true),
+					nullable.NewString("ぬるぽ", true),
+				},
+			},
+			{
+				"Time",
+				[]column{{memdriver.Postgres, "TIMESTAMPTZ"}, {memdriver.MySQL, "DATETIME(6)"}, {memdriver.SQLite, "TIMESTAMP"}},
+				[]driver.Valuer{
+					nullable.NewTime(time.Time{}, false),
+					nullable.NewTime(time.Date(2009, 1, 3, 18, 15, 5, 123456000, time.UTC), true),
+				},
+			},
+			{
+				"TimeOfDay",
+				[]column{{memdriver.Postgres, "TIME"}, {memdriver.MySQL, "TIME(6)"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewTimeOfDay(0, 0, 0, 0, false),
+					nullable.NewTimeOfDay(18, 15, 5, 123456000, true),
+				},
+			},
+			{
+				"Timestamp",
+				[]column{{memdriver.Postgres, "BIGINT"}, {memdriver.MySQL, "BIGINT"}, {memdriver.SQLite, "INTEGER"}},
+				[]driver.Valuer{
+					nullable.NewTimestamp(timeutil.Timestamp{}, false),
+					nullable.NewTimestamp(timeutil.NewTimestampFromUnix(1231006505), true),
+				},
+			},
+			{
+				"Uint8",
+				[]column{{memdriver.Postgres, "SMALLINT"}, {memdriver.MySQL, "TINYINT UNSIGNED"}, {memdriver.SQLite, "INTEGER"}},
+				[]driver.Valuer{
+					nullable.NewUint8(0, false),
+					nullable.NewUint8(math.MaxUint8, true),
+				},
+			},
+			{
+				"Uint16",
+				[]column{{memdriver.Postgres, "INTEGER"}, {memdriver.MySQL, "SMALLINT UNSIGNED"}, {memdriver.SQLite, "INTEGER"}},
+				[]driver.Valuer{
+					nullable.NewUint16(0, false),
+					nullable.NewUint16(math.MaxUint16, true),
+				},
+			},
+			{
+				"Uint32",
+				[]column{{memdriver.Postgres, "BIGINT"}, {memdriver.MySQL, "INT UNSIGNED"}, {memdriver.SQLite, "INTEGER"}},
+				[]driver.Valuer{
+					nullable.NewUint32(0, false),
+					nullable.NewUint32(math.MaxUint32, true),
+				},
+			},
+			{
+				"Uint256",
+				[]column{{memdriver.Postgres, "BYTEA"}, {memdriver.MySQL, "VARBINARY(32)"}, {memdriver.SQLite, "BLOB"}},
+				[]driver.Valuer{
+					nullable.NewUint256(bigutil.Uint256{}, false),
+					nullable.NewUint256(bigutil.NewUint256FromUint64(0), true),
+					nullable.NewUint256(bigutil.NewUint256FromUint64(math.MaxUint64), true),
+				},
+			},
+			{
+				"Uint64",
+				[]column{{memdriver.Postgres, "NUMERIC(20, 0)"}, {memdriver.MySQL, "BIGINT UNSIGNED"}},
+				[]driver.Valuer{
+					nullable.NewUint64(0, false),
+					nullable.NewUint64(0, true),
+					nullable.NewUint64(math.MaxUint64, true),
+				},
+			},
+			{
+				"Uint64: sqlite",
+				[]column{{memdriver.SQLite, "INTEGER"}},
+				[]driver.Valuer{
+					nullable.NewUint64(0, false),
+					nullable.NewUint64(math.MaxInt64, true),
+				},
+			},
+			{
+				"URL",
+				[]column{{memdriver.Postgres, "TEXT"}, {memdriver.MySQL, "TEXT"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewURL(url.URL{}, false),
+					nullable.NewURL(url.URL{Scheme: "wss", Host: "rpc.example.com", Path: "/v1"}, true),
+					nullable.NewURL(url.URL{Scheme: "s3", Host: "bucket", Path: "/a b", RawQuery: "versionId=1"}, true),
+				},
+			},
+			{
+				"UUID",
+				[]column{{memdriver.Postgres, "UUID"}, {memdriver.MySQL, "CHAR(36)"}, {memdriver.SQLite, "TEXT"}},
+				[]driver.Valuer{
+					nullable.NewUUID([16]byte{}, false),
+					nullable.NewUUID([16]byte{}, true),
+					nullable.NewUUIDv4(),
+				},
+			},
+			{
+				"UUIDBinary",
+				[]column{{memdriver.Postgres, "BYTEA"}, {memdriver.MySQL, "BINARY(16)"}, {memdriver.SQLite, "BLOB"}},
+				[]driver.Valuer{
+					nullable.NewUUIDBinary([16]byte{}, false),
+					nullable.NewUUIDBinary([16]byte{}, true),
+					nullable.UUIDBinary(nullable.NewUUIDv7()),
+				},
+			},
+		}
+
+		for _, tc := range tcs {
+			for _, col := range tc.columns {
+				t.Run(tc.name+": "+col.affinity.Name, func(t *testing.T) {
+					out, err := roundTrip(t, col.affinity, col.colType, tc.in)
+					require.NoError(t, err)
+					require.Len(t, out, len(tc.in))
+
+					for i, want := range tc.in {
+						wantV, err := want.Value()
+						require.NoError(t, err)
+
+						v, err := out[i].Value()
+						require.NoError(t, err)
+						require.Equal(t, wantV, v)
+					}
+				})
+			}
+		}
+	})
+}
+
+func TestOpen_Where(t *testing.T) {
+	db := memdriver.Open(memdriver.Postgres)
+	defer db.Close()
+
+	_, err := db.Exec(`CREATE TABLE "users" ("id" BIGINT NOT NULL, "name" TEXT)`)
+	require.NoError(t, err)
+
+	res, err := db.Exec(`INSERT INTO "users" ("id", "name") VALUES ($1, $2), ($3, NULL)`, 1, "alice", 2)
+	require.NoError(t, err)
+
+	n, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), n)
+
+	var name nullable.String
+	require.NoError(t, db.QueryRow(`SELECT name FROM users WHERE id = $1`, 1).Scan(&name))
+	require.Equal(t, nullable.NewString("alice", true), name)
+
+	require.NoError(t, db.QueryRow(`SELECT name FROM users WHERE id = $1`, 2).Scan(&name))
+	require.False(t, name.Valid)
+
+	require.ErrorIs(t, db.QueryRow(`SELECT name FROM users WHERE id = $1`, 3).Scan(&name), sql.ErrNoRows)
+
+	res, err = db.Exec(`DELETE FROM users WHERE id = $1`, 1)
+	require.NoError(t, err)
+
+	n, err = res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+
+	var count int
+	rows, err := db.Query(`SELECT * FROM users`)
+	require.NoError(t, err)
+	for rows.Next() {
+		count++
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, 1, count)
+}
@@ -0,0 +1,435 @@
+package memdriver
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type op int
+
+const (
+	opCreate op = iota
+	opInsert
+	opSelect
+	opDelete
+)
+
+type query struct {
+	op       op
+	table    string
+	numInput int
+
+	// CREATE TABLE
+	defs []column
+
+	// INSERT, SELECT (nil means *)
+	columns []string
+
+	// INSERT
+	tuples [][]param
+
+	// SELECT, DELETE
+	where      string
+	whereParam param
+}
+
+// param is a placeholder referring to args[index], or a NULL literal if null is true.
+type param struct {
+	index int
+	null  bool
+}
+
+func (p param) value(args []driver.Value) driver.Value {
+	if p.null {
+		return nil
+	}
+
+	return args[p.index]
+}
+
+type parser struct {
+	toks []string
+	pos  int
+
+	next     int
+	numbered bool
+	max      int
+}
+
+func parse(s string) (*query, error) {
+	toks, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{toks: toks}
+
+	q, err := p.query()
+	if err != nil {
+		return nil, fmt.Errorf("syntax error: %w: %s", err, s)
+	}
+
+	if p.pos < len(p.toks) {
+		return nil, fmt.Errorf("syntax error: unexpected %q: %s", p.toks[p.pos], s)
+	}
+
+	q.numInput = max(p.next, p.max)
+
+	return q, nil
+}
+
+func (p *parser) query() (*query, error) {
+	q := &query{}
+
+	switch {
+
+	case p.accept("CREATE"):
+		if err := p.expect("TABLE"); err != nil {
+			return nil, err
+		}
+		q.op = opCreate
+
+		var err error
+		if q.table, err = p.ident(); err != nil {
+			return nil, err
+		}
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		for {
+			def, err := p.columnDef()
+			if err != nil {
+				return nil, err
+			}
+			q.defs = append(q.defs, def)
+
+			if p.accept(")") {
+				break
+			}
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+
+	case p.accept("INSERT"):
+		if err := p.expect("INTO"); err != nil {
+			return nil, err
+		}
+		q.op = opInsert
+
+		var err error
+		if q.table, err = p.ident(); err != nil {
+			return nil, err
+		}
+		if q.columns, err = p.identList(); err != nil {
+			return nil, err
+		}
+		if err := p.expect("VALUES"); err != nil {
+			return nil, err
+		}
+		for {
+			if err := p.expect("("); err != nil {
+				return nil, err
+			}
+
+			var tuple []param
+			for {
+				prm, err := p.param()
+				if err != nil {
+					return nil, err
+				}
+				tuple = append(tuple, prm)
+
+				if p.accept(")") {
+					break
+				}
+				if err := p.expect(","); err != nil {
+					return nil, err
+				}
+			}
+			if len(tuple) != len(q.columns) {
+				return nil, fmt.Errorf("%d values for %d columns", len(tuple), len(q.columns))
+			}
+			q.tuples = append(q.tuples, tuple)
+
+			if !p.accept(",") {
+				break
+			}
+		}
+
+	case p.accept("SELECT"):
+		q.op = opSelect
+
+		if !p.accept("*") {
+			for {
+				name, err := p.ident()
+				if err != nil {
+					return nil, err
+				}
+				q.columns = append(q.columns, name)
+
+				if !p.accept(",") {
+					break
+				}
+			}
+		}
+		if err := p.expect("FROM"); err != nil {
+			return nil, err
+		}
+		if err := p.tableAndWhere(q); err != nil {
+			return nil, err
+		}
+
+	case p.accept("DELETE"):
+		if err := p.expect("FROM"); err != nil {
+			return nil, err
+		}
+		q.op = opDelete
+
+		if err := p.tableAndWhere(q); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported statement")
+	}
+
+	p.accept(";")
+
+	return q, nil
+}
+
+func (p *parser) tableAndWhere(q *query) error {
+	var err error
+	if q.table, err = p.ident(); err != nil {
+		return err
+	}
+
+	if !p.accept("WHERE") {
+		return nil
+	}
+
+	if q.where, err = p.ident(); err != nil {
+		return err
+	}
+	if err := p.expect("="); err != nil {
+		return err
+	}
+	if q.whereParam, err = p.param(); err != nil {
+		return err
+	}
+	if q.whereParam.null {
+		return fmt.Errorf("= NULL never matches; use a placeholder")
+	}
+
+	return nil
+}
+
+// columnDef parses "name TYPE [NULL | NOT NULL]", where TYPE may span several tokens,
+// e.g. DOUBLE PRECISION, NUMERIC(20, 0) or BIGINT UNSIGNED.
+func (p *parser) columnDef() (column, error) {
+	name, err := p.ident()
+	if err != nil {
+		return column{}, err
+	}
+
+	var (
+		typ     []string
+		depth   int
+		notNull bool
+	)
+	for p.pos < len(p.toks) {
+		tok := p.toks[p.pos]
+		if depth == 0 && (tok == "," || tok == ")") {
+			break
+		}
+
+		switch {
+		case tok == "(":
+			depth++
+		case tok == ")":
+			depth--
+		case depth == 0 && strings.EqualFold(tok, "NOT"):
+			p.pos++
+			if err := p.expect("NULL"); err != nil {
+				return column{}, err
+			}
+			notNull = true
+			continue
+		case depth == 0 && strings.EqualFold(tok, "NULL"):
+			p.pos++
+			continue
+		}
+
+		typ = append(typ, tok)
+		p.pos++
+	}
+	if len(typ) == 0 {
+		return column{}, fmt.Errorf("missing type for column %s", name)
+	}
+
+	return column{
+		name:     name,
+		declType: joinType(typ),
+		notNull:  notNull,
+	}, nil
+}
+
+func joinType(toks []string) string {
+	var b strings.Builder
+	for i, tok := range toks {
+		if i > 0 && tok != "(" && tok != ")" && tok != "," && toks[i-1] != "(" {
+			b.WriteByte(' ')
+		}
+		b.WriteString(tok)
+	}
+
+	return b.String()
+}
+
+func (p *parser) identList() ([]string, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+
+		if p.accept(")") {
+			return names, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) ident() (string, error) {
+	if p.pos >= len(p.toks) {
+		return "", fmt.Errorf("unexpected end of statement")
+	}
+
+	tok := p.toks[p.pos]
+	switch {
+	case len(tok) >= 2 && (tok[0] == '"' || tok[0] == '`'):
+		tok = tok[1 : len(tok)-1]
+	case !isIdent(tok):
+		return "", fmt.Errorf("unexpected %q", tok)
+	}
+	p.pos++
+
+	return tok, nil
+}
+
+func (p *parser) param() (param, error) {
+	if p.accept("NULL") {
+		return param{null: true}, nil
+	}
+
+	if p.pos >= len(p.toks) {
+		return param{}, fmt.Errorf("unexpected end of statement")
+	}
+
+	tok := p.toks[p.pos]
+	switch {
+	case tok == "?":
+		if p.numbered {
+			return param{}, fmt.Errorf("mixed placeholder styles")
+		}
+		p.pos++
+		p.next++
+
+		return param{index: p.next - 1}, nil
+
+	case strings.HasPrefix(tok, "$"):
+		n, err := strconv.Atoi(tok[1:])
+		if err != nil || n < 1 {
+			return param{}, fmt.Errorf("invalid placeholder %q", tok)
+		}
+		if p.next > 0 {
+			return param{}, fmt.Errorf("mixed placeholder styles")
+		}
+		p.pos++
+		p.numbered = true
+		p.max = max(p.max, n)
+
+		return param{index: n - 1}, nil
+	}
+
+	return param{}, fmt.Errorf("unexpected %q; only placeholders and NULL are supported", tok)
+}
+
+func (p *parser) accept(tok string) bool {
+	if p.pos < len(p.toks) && strings.EqualFold(p.toks[p.pos], tok) {
+		p.pos++
+
+		return true
+	}
+
+	return false
+}
+
+func (p *parser) expect(tok string) error {
+	if p.accept(tok) {
+		return nil
+	}
+
+	if p.pos >= len(p.toks) {
+		return fmt.Errorf("expected %q, got end of statement", tok)
+	}
+
+	return fmt.Errorf("expected %q, got %q", tok, p.toks[p.pos])
+}
+
+func isIdent(tok string) bool {
+	for i, r := range tok {
+		if !(r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r))) {
+			return false
+		}
+	}
+
+	return tok != ""
+}
+
+func tokenize(s string) ([]string, error) {
+	var toks []string
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c == '"' || c == '`':
+			j := strings.IndexByte(s[i+1:], c)
+			if j < 0 {
+				return nil, fmt.Errorf("syntax error: unterminated identifier: %s", s)
+			}
+			toks = append(toks, s[i:i+j+2])
+			i += j + 2
+
+		case strings.IndexByte("(),;*=?", c) >= 0:
+			toks = append(toks, string(c))
+			i++
+
+		default:
+			j := i + 1
+			for j < len(s) && strings.IndexByte(" \t\n\r\"`(),;*=?", s[j]) < 0 {
+				j++
+			}
+			toks = append(toks, s[i:j])
+			i = j
+		}
+	}
+
+	return toks, nil
+}
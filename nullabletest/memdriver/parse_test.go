@@ -0,0 +1,99 @@
+package memdriver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest/memdriver"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			args []any
+			want string
+		}{
+			{
+				"unsupported statement",
+				`UPDATE t SET v = ?`,
+				[]any{1},
+				"syntax error: unsupported statement",
+			},
+			{
+				"missing type",
+				`CREATE TABLE u (v)`,
+				nil,
+				"syntax error: missing type for column v",
+			},
+			{
+				"unterminated identifier",
+				`SELECT "v FROM t`,
+				nil,
+				"syntax error: unterminated identifier",
+			},
+			{
+				"literal",
+				`INSERT INTO t (v) VALUES (1)`,
+				nil,
+				`syntax error: unexpected "1"; only placeholders and NULL are supported`,
+			},
+			{
+				"values count",
+				`INSERT INTO t (v) VALUES (?, ?)`,
+				[]any{1, 2},
+				"syntax error: 2 values for 1 columns",
+			},
+			{
+				"mixed placeholders",
+				`INSERT INTO t (v) VALUES (?), ($2)`,
+				[]any{1, 2},
+				"syntax error: mixed placeholder styles",
+			},
+			{
+				"trailing tokens",
+				`SELECT v FROM t WHERE v = ? LIMIT 1`,
+				[]any{1},
+				`syntax error: unexpected "LIMIT"`,
+			},
+			{
+				"unknown table",
+				`SELECT v FROM u`,
+				nil,
+				"unknown table: u",
+			},
+			{
+				"unknown column",
+				`INSERT INTO t (w) VALUES (?)`,
+				[]any{1},
+				"unknown column: w",
+			},
+			{
+				"duplicate table",
+				`CREATE TABLE t (v TEXT)`,
+				nil,
+				"table already exists: t",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				db := memdriver.Open(memdriver.Postgres)
+				defer db.Close()
+
+				_, err := db.Exec(`CREATE TABLE t (v BIGINT)`)
+				require.NoError(t, err)
+
+				if strings.HasPrefix(tc.in, "SELECT") {
+					_, err = db.Query(tc.in, tc.args...)
+				} else {
+					_, err = db.Exec(tc.in, tc.args...)
+				}
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+}
@@ -0,0 +1,174 @@
+// Package nullabletest provides a conformance test suite for nullable types.
+//
+// A nullable type conforms if its zero value is null and it round-trips its valid values
+// through database/sql, JSON and, when implemented, text and YAML, as the types in the
+// nullable package do.
+package nullabletest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+)
+
+// Nullable is the interface implemented by the value type of a nullable type.
+type Nullable interface {
+	driver.Valuer
+	json.Marshaler
+}
+
+// NullablePtr is the interface implemented by the pointer type of a nullable type T.
+type NullablePtr[T any] interface {
+	*T
+	sql.Scanner
+	json.Unmarshaler
+}
+
+// Spec describes a nullable type under test.
+type Spec[T Nullable] struct {
+	// Valid holds non-null values to round-trip.
+	Valid []T
+
+	// InvalidScan holds sources that Scan must reject.
+	InvalidScan []any
+
+	// InvalidJSON holds JSON inputs that UnmarshalJSON must reject.
+	InvalidJSON [][]byte
+
+	// Equal reports whether two values are equal.
+	// If nil, values are compared by their driver.Value and JSON encoding.
+	Equal func(a, b T) bool
+}
+
+func (spec Spec[T]) equal(t *testing.T, a, b T) bool {
+	if spec.Equal != nil {
+		return spec.Equal(a, b)
+	}
+
+	return equal(t, a, b)
+}
+
+// RunConformance runs the conformance suite for the nullable type T as subtests of t.
+//
+// It checks that:
+//   - the zero value is null: Value returns nil and MarshalJSON returns null
+//   - Scan(nil) and UnmarshalJSON(null) reset a valid value to the zero value
+//   - every valid value round-trips through Value and Scan, MarshalJSON and UnmarshalJSON,
+//     and, if implemented, MarshalText and UnmarshalText, and YAML
+//   - the invalid inputs are rejected
+func RunConformance[T Nullable, PT NullablePtr[T]](t *testing.T, spec Spec[T]) {
+	t.Helper()
+
+	require.NotEmpty(t, spec.Valid, "spec must have at least one valid value")
+
+	t.Run("null: zero value", func(t *testing.T) {
+		var n T
+
+		v, err := n.Value()
+		require.NoError(t, err)
+		require.Nil(t, v)
+
+		b, err := n.MarshalJSON()
+		require.NoError(t, err)
+		require.Equal(t, []byte(`null`), b)
+
+		b, err = json.Marshal(struct{ N T }{n})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"N":null}`, string(b))
+	})
+
+	t.Run("null: Scan resets the value", func(t *testing.T) {
+		for _, valid := range spec.Valid {
+			n := valid
+			require.NoError(t, PT(&n).Scan(nil))
+			require.Equal(t, *new(T), n)
+		}
+	})
+
+	t.Run("null: UnmarshalJSON resets the value", func(t *testing.T) {
+		for _, valid := range spec.Valid {
+			n := valid
+			require.NoError(t, PT(&n).UnmarshalJSON([]byte(`null`)))
+			require.Equal(t, *new(T), n)
+
+			n = valid
+			require.NoError(t, json.Unmarshal([]byte(`null`), PT(&n)))
+			require.Equal(t, *new(T), n)
+		}
+	})
+
+	t.Run("valid: round-trips through database/sql", func(t *testing.T) {
+		for _, valid := range spec.Valid {
+			v, err := valid.Value()
+			require.NoError(t, err)
+			require.NotNil(t, v)
+
+			var n T
+			require.NoError(t, PT(&n).Scan(v))
+			require.True(t, spec.equal(t, valid, n), "got %v, want %v", n, valid)
+		}
+	})
+
+	t.Run("valid: round-trips through JSON", func(t *testing.T) {
+		for _, valid := range spec.Valid {
+			b, err := valid.MarshalJSON()
+			require.NoError(t, err)
+			require.NotEqual(t, []byte(`null`), b)
+
+			var n T
+			require.NoError(t, PT(&n).UnmarshalJSON(b))
+			require.True(t, spec.equal(t, valid, n), "got %v, want %v (JSON: %s)", n, valid, b)
+		}
+	})
+
+	if _, ok := any(*new(T)).(encoding.TextMarshaler); ok {
+		t.Run("valid: round-trips through text", func(t *testing.T) {
+			for _, valid := range spec.Valid {
+				b, err := any(valid).(encoding.TextMarshaler).MarshalText()
+				require.NoError(t, err)
+
+				var n T
+				u, ok := any(PT(&n)).(encoding.TextUnmarshaler)
+				require.True(t, ok, "%T implements encoding.TextMarshaler but not encoding.TextUnmarshaler", n)
+				require.NoError(t, u.UnmarshalText(b))
+				require.True(t, spec.equal(t, valid, n), "got %v, want %v (text: %s)", n, valid, b)
+			}
+		})
+	}
+
+	if _, ok := any(*new(T)).(yaml.Marshaler); ok {
+		t.Run("valid: round-trips through YAML", func(t *testing.T) {
+			for _, valid := range spec.Valid {
+				b, err := yaml.Marshal(valid)
+				require.NoError(t, err)
+
+				var n T
+				require.NoError(t, yaml.Unmarshal(b, PT(&n)))
+				require.True(t, spec.equal(t, valid, n), "got %v, want %v (YAML: %s)", n, valid, b)
+			}
+		})
+	}
+
+	if len(spec.InvalidScan) > 0 {
+		t.Run("invalid: Scan", func(t *testing.T) {
+			for _, src := range spec.InvalidScan {
+				var n T
+				require.Error(t, PT(&n).Scan(src), "source: %#v", src)
+			}
+		})
+	}
+
+	if len(spec.InvalidJSON) > 0 {
+		t.Run("invalid: UnmarshalJSON", func(t *testing.T) {
+			for _, b := range spec.InvalidJSON {
+				var n T
+				require.Error(t, PT(&n).UnmarshalJSON(b), "JSON: %s", b)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package nullabletest_test
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+// Code is a nullable upper-case code, as a downstream custom type would be defined.
+type Code struct {
+	Code  string
+	Valid bool
+}
+
+func (n Code) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Code, nil
+}
+
+func (n *Code) Scan(src any) error {
+	if src == nil {
+		n.Code, n.Valid = "", false
+
+		return nil
+	}
+
+	s, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+
+	return n.set(s)
+}
+
+func (n Code) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.Code)
+}
+
+func (n *Code) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.Code, n.Valid = "", false
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	return n.set(s)
+}
+
+func (n Code) MarshalText() ([]byte, error) {
+	return []byte(n.Code), nil
+}
+
+func (n *Code) UnmarshalText(b []byte) error {
+	return n.set(string(b))
+}
+
+func (n *Code) set(s string) error {
+	if s == "" || strings.ToUpper(s) != s {
+		return fmt.Errorf("invalid code: %q", s)
+	}
+
+	n.Code, n.Valid = s, true
+
+	return nil
+}
+
+func TestRunConformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[Code]{
+		Valid: []Code{
+			{"A", true},
+			{"NULL", true},
+		},
+		InvalidScan: []any{
+			int64(0),
+			"a",
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`0`),
+			[]byte(`""`),
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package nullabletest
+
+import (
+	"fmt"
+	"math/rand"
+	"testing/quick"
+)
+
+// Generator is the interface implemented by the value type of a nullable type
+// that can generate random values of itself.
+type Generator interface {
+	Nullable
+	quick.Generator
+}
+
+// Rand produces random nullable values deterministically from a seed.
+type Rand struct {
+	rand *rand.Rand
+
+	// NullRatio is the probability, in [0, 1], that a value is null.
+	NullRatio float64
+
+	// Size bounds the size of variable-length values such as strings.
+	Size int
+}
+
+// NewRand returns a new Rand seeded with seed, with a NullRatio of 0.1 and a Size of 50.
+func NewRand(seed int64) *Rand {
+	return &Rand{
+		rand:      rand.New(rand.NewSource(seed)),
+		NullRatio: 0.1,
+		Size:      50,
+	}
+}
+
+// Config returns a quick.Config drawing from r, for use with quick.Check.
+// Values quick.Check generates follow the types' own null ratios, not r.NullRatio.
+func (r *Rand) Config() *quick.Config {
+	return &quick.Config{
+		Rand: r.rand,
+	}
+}
+
+// maxAttempts is the number of values Value draws from T's Generate method
+// before giving up on getting a non-null one.
+const maxAttempts = 1000
+
+// Value returns a random value of the nullable type T.
+// It is null with probability r.NullRatio; otherwise it is generated by T's Generate method,
+// which is biased towards edge cases.
+// It panics if Generate returns no non-null value in maxAttempts attempts.
+func Value[T Generator](r *Rand) T {
+	if r.rand.Float64() < r.NullRatio {
+		return *new(T)
+	}
+
+	for range maxAttempts {
+		n := (*new(T)).Generate(r.rand, r.Size).Interface().(T)
+		if v, err := n.Value(); err == nil && v != nil {
+			return n
+		}
+	}
+
+	panic(fmt.Errorf("nullabletest: %T generated no non-null value in %d attempts", *new(T), maxAttempts))
+}
+
+// Values returns n random values of the nullable type T, as Value does.
+func Values[T Generator](r *Rand, n int) []T {
+	ns := make([]T, n)
+	for i := range ns {
+		ns[i] = Value[T](r)
+	}
+
+	return ns
+}
@@ -0,0 +1,116 @@
+package nullabletest_test
+
+import (
+	"database/sql/driver"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestNewRand(t *testing.T) {
+	t.Run("deterministic", func(t *testing.T) {
+		a := nullabletest.Values[nullable.Uint64](nullabletest.NewRand(1), 100)
+		b := nullabletest.Values[nullable.Uint64](nullabletest.NewRand(1), 100)
+		require.Equal(t, a, b)
+
+		c := nullabletest.Values[nullable.Uint64](nullabletest.NewRand(2), 100)
+		require.NotEqual(t, a, c)
+	})
+
+	t.Run("config", func(t *testing.T) {
+		r := nullabletest.NewRand(1)
+		require.NoError(t, quick.Check(func(n nullable.Uint64) bool {
+			var n2 nullable.Uint64
+			b, err := n.MarshalJSON()
+
+			return err == nil && n2.UnmarshalJSON(b) == nil && n2 == n
+		}, r.Config()))
+	})
+}
+
+func TestValues(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name      string
+			nullRatio float64
+			min, max  int
+		}{
+			{
+				"never null",
+				0,
+				0, 0,
+			},
+			{
+				"default",
+				0.1,
+				50, 150,
+			},
+			{
+				"half",
+				0.5,
+				400, 600,
+			},
+			{
+				"always null",
+				1,
+				1000, 1000,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				r := nullabletest.NewRand(1)
+				r.NullRatio = tc.nullRatio
+
+				var nulls int
+				for _, n := range nullabletest.Values[nullable.Int64](r, 1000) {
+					if !n.Valid {
+						nulls++
+					}
+				}
+				require.GreaterOrEqual(t, nulls, tc.min)
+				require.LessOrEqual(t, nulls, tc.max)
+			})
+		}
+	})
+
+	t.Run("size", func(t *testing.T) {
+		r := nullabletest.NewRand(1)
+		r.Size = 4
+
+		for _, n := range nullabletest.Values[nullable.String](r, 1000) {
+			require.LessOrEqual(t, len([]rune(n.String)), 4)
+		}
+	})
+}
+
+type alwaysNull struct{}
+
+func (alwaysNull) Value() (driver.Value, error) {
+	return nil, nil
+}
+
+func (alwaysNull) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+func (alwaysNull) Generate(*rand.Rand, int) reflect.Value {
+	return reflect.ValueOf(alwaysNull{})
+}
+
+func TestValue(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		r := nullabletest.NewRand(1)
+		r.NullRatio = 0
+
+		require.PanicsWithError(t, "nullabletest: nullabletest_test.alwaysNull generated no non-null value in 1000 attempts", func() {
+			nullabletest.Value[alwaysNull](r)
+		})
+	})
+}
@@ -0,0 +1,341 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// Number represents a nullable number in JSON syntax, such as "12345678901234567890.123",
+// with the semantics of json.Number.
+//
+// It keeps the exact text of the number, which it never parses into a float64:
+// the text passes through JSON, text, YAML and database/sql unchanged.
+// Int64, Uint64, Float64 and BigInt convert it, reporting conversions that would lose precision.
+//
+// PostgreSQL NUMERIC columns keep its digits and trailing zeros but not exponent notation,
+// and MySQL DECIMAL columns pad it to their scale; use a text column to keep the text verbatim.
+type Number struct {
+	Number json.Number
+	Valid  bool
+}
+
+// NewNumber returns a new Number.
+func NewNumber(s json.Number, valid bool) Number {
+	return Number{
+		Number: s,
+		Valid:  valid,
+	}
+}
+
+// ParseNumber returns a new valid Number from a number in JSON syntax, such as "-1.5e+10".
+func ParseNumber(s string) (Number, error) {
+	if !isJSONNumber(s) {
+		return Number{}, fmt.Errorf("invalid number %q", s)
+	}
+
+	return NewNumber(json.Number(s), true), nil
+}
+
+// NullableString returns the text of the value as a String.
+func (n Number) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(string(n.Number), true)
+}
+
+// Int64 returns the value as an int64.
+// It returns an error if invalid, or if the value is not an integer or is out of int64 range;
+// a fraction or exponent is accepted if the value is still a whole number, such as "1.0" or "1e3".
+func (n Number) Int64() (int64, error) {
+	x, err := n.BigInt()
+	if err != nil {
+		return 0, err
+	}
+
+	if !x.IsInt64() {
+		return 0, fmt.Errorf("%s is out of int64 range", n.Number)
+	}
+
+	return x.Int64(), nil
+}
+
+// Uint64 returns the value as a uint64.
+// It returns an error if invalid, or if the value is not an integer or is out of uint64 range.
+func (n Number) Uint64() (uint64, error) {
+	x, err := n.BigInt()
+	if err != nil {
+		return 0, err
+	}
+
+	if !x.IsUint64() {
+		return 0, fmt.Errorf("%s is out of uint64 range", n.Number)
+	}
+
+	return x.Uint64(), nil
+}
+
+// Float64 returns the value as a float64.
+// It returns an error if invalid, or if the float64 does not format back to the same value,
+// such as for "0.10000000000000001", "1e400" or "12345678901234567890.123".
+func (n Number) Float64() (float64, error) {
+	if !n.Valid {
+		return 0, errNumberNull
+	}
+
+	f, err := strconv.ParseFloat(string(n.Number), 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s is not exactly representable as a float64", n.Number)
+	}
+
+	d, err := ParseDecimal(string(n.Number))
+	if err != nil {
+		return 0, err
+	}
+
+	fd, err := ParseDecimal(strconv.FormatFloat(f, 'g', -1, 64))
+	if err != nil || fd.Compare(d) != 0 {
+		return 0, fmt.Errorf("%s is not exactly representable as a float64", n.Number)
+	}
+
+	return f, nil
+}
+
+// BigInt returns the value as a *big.Int.
+// It returns an error if invalid, or if the value is not an integer.
+func (n Number) BigInt() (*big.Int, error) {
+	if !n.Valid {
+		return nil, errNumberNull
+	}
+
+	d, err := ParseDecimal(string(n.Number))
+	if err != nil {
+		return nil, err
+	}
+
+	if d.Scale <= 0 {
+		return new(big.Int).Mul(d.coefficient(), pow10(-d.Scale)), nil
+	}
+
+	q, m := new(big.Int).QuoRem(d.coefficient(), pow10(d.Scale), new(big.Int))
+	if m.Sign() != 0 {
+		return nil, fmt.Errorf("%s is not an integer", n.Number)
+	}
+
+	return q, nil
+}
+
+// Value implements driver.Valuer.
+// It returns the text of the value as a string, or nil if invalid.
+func (n Number) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	if !isJSONNumber(string(n.Number)) {
+		return nil, fmt.Errorf("invalid number %q", n.Number)
+	}
+
+	return string(n.Number), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts one of the following:
+//   - string (number in JSON syntax)
+//   - []byte (number in JSON syntax, as NUMERIC columns return)
+//   - int64
+//   - uint64
+//   - float64 (its shortest representation; NaN and infinities are rejected)
+//   - nil
+func (n *Number) Scan(src any) error {
+	if src == nil {
+		n.Number, n.Valid = "", false
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case string:
+		return n.parse(v)
+
+	case []byte:
+		return n.parse(string(v))
+
+	case int64:
+		n.Number, n.Valid = json.Number(strconv.FormatInt(v, 10)), true
+
+		return nil
+
+	case uint64:
+		n.Number, n.Valid = json.Number(strconv.FormatUint(v, 10)), true
+
+		return nil
+
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("invalid source: %v", v)
+		}
+
+		n.Number, n.Valid = json.Number(strconv.FormatFloat(v, 'g', -1, 64)), true
+
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the text of the value as a JSON number, or null if invalid.
+func (n Number) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	if !isJSONNumber(string(n.Number)) {
+		return nil, fmt.Errorf("invalid number %q", n.Number)
+	}
+
+	return []byte(n.Number), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number, a JSON string holding a number, or null.
+func (n *Number) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.Number, n.Valid = "", false
+
+		return nil
+	}
+
+	if !json.Valid(b) {
+		return fmt.Errorf("invalid JSON: %s", b)
+	}
+
+	if b[0] != '"' {
+		return n.parse(string(b))
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	return n.parse(s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns the text of the value, or an empty text if invalid.
+func (n Number) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	if !isJSONNumber(string(n.Number)) {
+		return nil, fmt.Errorf("invalid number %q", n.Number)
+	}
+
+	return []byte(n.Number), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts a number in JSON syntax, or an empty text as null.
+func (n *Number) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		n.Number, n.Valid = "", false
+
+		return nil
+	}
+
+	return n.parse(string(b))
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It returns the text of the value as a plain YAML scalar, or nil if invalid.
+func (n Number) MarshalYAML() (any, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	if !isJSONNumber(string(n.Number)) {
+		return nil, fmt.Errorf("invalid number %q", n.Number)
+	}
+
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Value: string(n.Number),
+	}, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It accepts a YAML number or string in JSON number syntax, or null.
+func (n *Number) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Tag {
+
+	case "!!null":
+		n.Number, n.Valid = "", false
+
+		return nil
+
+	default:
+		var s string
+		if err := value.Decode(&s); err != nil {
+			return err
+		}
+
+		return n.parse(s)
+	}
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (0, -0, 1.50, a number beyond float64 precision or range,
+// or one in exponent notation), or a random integer or float64.
+func (Number) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewNumber("", false))
+	}
+
+	if s, ok := generateEdge(r, "0", "-0", "1.50", "12345678901234567890.123", "1e400", "-1.5E-7"); ok {
+		return reflect.ValueOf(NewNumber(json.Number(s), true))
+	}
+
+	if r.Intn(2) == 0 {
+		return reflect.ValueOf(NewNumber(json.Number(strconv.FormatInt(r.Int63()-r.Int63(), 10)), true))
+	}
+
+	return reflect.ValueOf(NewNumber(json.Number(strconv.FormatFloat(r.NormFloat64()*math.Pow10(r.Intn(41)-20), 'g', -1, 64)), true))
+}
+
+var errNumberNull = errors.New("null number")
+
+func (n *Number) parse(s string) error {
+	if !isJSONNumber(s) {
+		return fmt.Errorf("invalid source: invalid number %q", s)
+	}
+
+	n.Number, n.Valid = json.Number(s), true
+
+	return nil
+}
+
+// isJSONNumber reports whether s is a single number in JSON syntax, without surrounding whitespace.
+func isJSONNumber(s string) bool {
+	if s == "" || !strings.ContainsRune("-0123456789", rune(s[0])) || !strings.ContainsRune("0123456789", rune(s[len(s)-1])) {
+		return false
+	}
+
+	return json.Valid([]byte(s))
+}
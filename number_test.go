@@ -0,0 +1,1028 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestNumber(t *testing.T) {
+	var n nullable.Number
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextMarshaler)(nil), &n)
+	require.Implements(t, (*yaml.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextUnmarshaler)(nil), &n)
+	require.Implements(t, (*yaml.Unmarshaler)(nil), &n)
+}
+
+func TestNumber_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Number]{
+		Valid: []nullable.Number{
+			nullable.NewNumber("0", true),
+			nullable.NewNumber("-0", true),
+			nullable.NewNumber("1.50", true),
+			nullable.NewNumber("12345678901234567890.123", true),
+			nullable.NewNumber("1e400", true),
+			nullable.NewNumber("-1.5E-7", true),
+		},
+		InvalidScan: []any{
+			"",
+			"NaN",
+			"+1",
+			[]byte(" 1"),
+			math.Inf(1),
+			true,
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`"01"`),
+			[]byte(`".5"`),
+			[]byte(`"0x1f"`),
+		},
+	})
+}
+
+func TestParseNumber(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+		}{
+			{"empty", ""},
+			{"plus sign", "+1"},
+			{"leading zero", "01"},
+			{"leading point", ".5"},
+			{"trailing point", "1."},
+			{"empty exponent", "1e"},
+			{"hex", "0x1f"},
+			{"NaN", "NaN"},
+			{"infinity", "Infinity"},
+			{"leading whitespace", " 1"},
+			{"trailing whitespace", "1 "},
+			{"two numbers", "1 2"},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := nullable.ParseNumber(tc.in)
+				require.ErrorContains(t, err, "invalid number")
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+		}{
+			{"zero", "0"},
+			{"negative zero", "-0"},
+			{"trailing zero", "1.50"},
+			{"beyond float64 precision", "12345678901234567890.123"},
+			{"beyond float64 range", "1e400"},
+			{"exponent", "-1.5E-7"},
+			{"signed exponent", "1e+10"},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n, err := nullable.ParseNumber(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, nullable.NewNumber(json.Number(tc.in), true), n)
+			})
+		}
+	})
+}
+
+func TestNumber_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewNumber("1", false),
+				nullable.NewString("", false),
+			},
+			{
+				"valid",
+				nullable.NewNumber("1.50", true),
+				nullable.NewString("1.50", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.NullableString())
+			})
+		}
+	})
+}
+
+func TestNumber_Int64(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want string
+		}{
+			{
+				"null",
+				nullable.NewNumber("1", false),
+				"null number",
+			},
+			{
+				"fraction",
+				nullable.NewNumber("1.5", true),
+				"1.5 is not an integer",
+			},
+			{
+				"overflow",
+				nullable.NewNumber("9223372036854775808", true),
+				"9223372036854775808 is out of int64 range",
+			},
+			{
+				"underflow",
+				nullable.NewNumber("-9223372036854775809", true),
+				"-9223372036854775809 is out of int64 range",
+			},
+			{
+				"exponent out of range",
+				nullable.NewNumber("1e999999", true),
+				"scale out of range",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.Int64()
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want int64
+		}{
+			{
+				"negative zero",
+				nullable.NewNumber("-0", true),
+				0,
+			},
+			{
+				"trailing zeros",
+				nullable.NewNumber("1.000", true),
+				1,
+			},
+			{
+				"exponent",
+				nullable.NewNumber("1.5e3", true),
+				1500,
+			},
+			{
+				"min",
+				nullable.NewNumber("-9223372036854775808", true),
+				math.MinInt64,
+			},
+			{
+				"max",
+				nullable.NewNumber("9223372036854775807", true),
+				math.MaxInt64,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				i, err := tc.in.Int64()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, i)
+			})
+		}
+	})
+}
+
+func TestNumber_Uint64(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want string
+		}{
+			{
+				"null",
+				nullable.NewNumber("1", false),
+				"null number",
+			},
+			{
+				"fraction",
+				nullable.NewNumber("1e-1", true),
+				"1e-1 is not an integer",
+			},
+			{
+				"negative",
+				nullable.NewNumber("-1", true),
+				"-1 is out of uint64 range",
+			},
+			{
+				"overflow",
+				nullable.NewNumber("18446744073709551616", true),
+				"18446744073709551616 is out of uint64 range",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.Uint64()
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want uint64
+		}{
+			{
+				"zero",
+				nullable.NewNumber("0", true),
+				0,
+			},
+			{
+				"max",
+				nullable.NewNumber("18446744073709551615", true),
+				math.MaxUint64,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				u, err := tc.in.Uint64()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, u)
+			})
+		}
+	})
+}
+
+func TestNumber_Float64(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want string
+		}{
+			{
+				"null",
+				nullable.NewNumber("1", false),
+				"null number",
+			},
+			{
+				"beyond float64 precision",
+				nullable.NewNumber("12345678901234567890.123", true),
+				"12345678901234567890.123 is not exactly representable as a float64",
+			},
+			{
+				"beyond shortest representation",
+				nullable.NewNumber("0.10000000000000001", true),
+				"0.10000000000000001 is not exactly representable as a float64",
+			},
+			{
+				"overflow",
+				nullable.NewNumber("1e400", true),
+				"1e400 is not exactly representable as a float64",
+			},
+			{
+				"underflow",
+				nullable.NewNumber("1e-400", true),
+				"1e-400 is not exactly representable as a float64",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.Float64()
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want float64
+		}{
+			{
+				"negative zero",
+				nullable.NewNumber("-0", true),
+				math.Copysign(0, -1),
+			},
+			{
+				"trailing zero",
+				nullable.NewNumber("1.50", true),
+				1.5,
+			},
+			{
+				"shortest representation",
+				nullable.NewNumber("0.1", true),
+				0.1,
+			},
+			{
+				"exponent",
+				nullable.NewNumber("-1.5E-7", true),
+				-1.5e-7,
+			},
+			{
+				"max",
+				nullable.NewNumber("1.7976931348623157e308", true),
+				math.MaxFloat64,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				f, err := tc.in.Float64()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, f)
+			})
+		}
+	})
+}
+
+func TestNumber_BigInt(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want string
+		}{
+			{
+				"null",
+				nullable.NewNumber("1", false),
+				"null number",
+			},
+			{
+				"fraction",
+				nullable.NewNumber("12345678901234567890.123", true),
+				"12345678901234567890.123 is not an integer",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.BigInt()
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want *big.Int
+		}{
+			{
+				"beyond uint64 range",
+				nullable.NewNumber("-18446744073709551616", true),
+				new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 64)),
+			},
+			{
+				"trailing zeros",
+				nullable.NewNumber("12345678901234567890.000", true),
+				new(big.Int).SetUint64(12345678901234567890),
+			},
+			{
+				"exponent",
+				nullable.NewNumber("1e20", true),
+				new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				x, err := tc.in.BigInt()
+				require.NoError(t, err)
+				require.Zero(t, tc.want.Cmp(x), "got %s, want %s", x, tc.want)
+			})
+		}
+	})
+}
+
+func TestNumber_Value(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want string
+		}{
+			{
+				"empty",
+				nullable.NewNumber("", true),
+				`invalid number ""`,
+			},
+			{
+				"not a number",
+				nullable.NewNumber("NaN", true),
+				`invalid number "NaN"`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.Value()
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewNumber("", false),
+				nil,
+			},
+			{
+				"trailing zero",
+				nullable.NewNumber("1.50", true),
+				"1.50",
+			},
+			{
+				"beyond float64 precision",
+				nullable.NewNumber("12345678901234567890.123", true),
+				"12345678901234567890.123",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestNumber_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"bool",
+				true,
+				"unsupported source type: bool",
+			},
+			{
+				"float64: NaN",
+				math.NaN(),
+				"invalid source: NaN",
+			},
+			{
+				"float64: infinity",
+				math.Inf(-1),
+				"invalid source: -Inf",
+			},
+			{
+				"string: empty",
+				"",
+				`invalid source: invalid number ""`,
+			},
+			{
+				"[]byte: NaN",
+				[]byte("NaN"),
+				`invalid source: invalid number "NaN"`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Number
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.Number
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewNumber("", false),
+			},
+			{
+				"string",
+				"1.50",
+				nullable.NewNumber("1.50", true),
+			},
+			{
+				"[]byte",
+				[]byte("12345678901234567890.123"),
+				nullable.NewNumber("12345678901234567890.123", true),
+			},
+			{
+				"int64",
+				int64(math.MinInt64),
+				nullable.NewNumber("-9223372036854775808", true),
+			},
+			{
+				"uint64",
+				uint64(math.MaxUint64),
+				nullable.NewNumber("18446744073709551615", true),
+			},
+			{
+				"float64",
+				1e21,
+				nullable.NewNumber("1e+21", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Number
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestNumber_MarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := nullable.NewNumber("NaN", true).MarshalJSON()
+		require.ErrorContains(t, err, `invalid number "NaN"`)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewNumber("", false),
+				[]byte(`null`),
+			},
+			{
+				"trailing zero",
+				nullable.NewNumber("1.50", true),
+				[]byte(`1.50`),
+			},
+			{
+				"beyond float64 precision",
+				nullable.NewNumber("12345678901234567890.123", true),
+				[]byte(`12345678901234567890.123`),
+			},
+			{
+				"beyond float64 range",
+				nullable.NewNumber("1e400", true),
+				[]byte(`1e400`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestNumber_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"empty",
+				[]byte{},
+				"invalid JSON",
+			},
+			{
+				"boolean",
+				[]byte(`true`),
+				`invalid number "true"`,
+			},
+			{
+				"array",
+				[]byte(`[1]`),
+				"invalid number",
+			},
+			{
+				"string: empty",
+				[]byte(`""`),
+				`invalid number ""`,
+			},
+			{
+				"string: whitespace",
+				[]byte(`" 1"`),
+				`invalid number " 1"`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Number
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Number
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewNumber("", false),
+			},
+			{
+				"number",
+				[]byte(`12345678901234567890.123`),
+				nullable.NewNumber("12345678901234567890.123", true),
+			},
+			{
+				"number: exponent",
+				[]byte(`-1.5E-7`),
+				nullable.NewNumber("-1.5E-7", true),
+			},
+			{
+				"string",
+				[]byte(`"1.50"`),
+				nullable.NewNumber("1.50", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Number
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+
+	t.Run("success: in a struct", func(t *testing.T) {
+		var v struct {
+			Amount nullable.Number `json:"amount"`
+		}
+		b := []byte(`{"amount":12345678901234567890.123}`)
+
+		require.NoError(t, json.Unmarshal(b, &v))
+		require.Equal(t, nullable.NewNumber("12345678901234567890.123", true), v.Amount)
+
+		out, err := json.Marshal(v)
+		require.NoError(t, err)
+		require.Equal(t, b, out)
+	})
+}
+
+func TestNumber_MarshalText(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewNumber("", false),
+				[]byte{},
+			},
+			{
+				"exponent",
+				nullable.NewNumber("-1.5E-7", true),
+				[]byte("-1.5E-7"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := tc.in.MarshalText()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestNumber_UnmarshalText(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		var n nullable.Number
+		err := n.UnmarshalText([]byte("1_000"))
+		require.ErrorContains(t, err, `invalid source: invalid number "1_000"`)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Number
+		}{
+			{
+				"empty",
+				[]byte{},
+				nullable.NewNumber("", false),
+			},
+			{
+				"exponent",
+				[]byte("-1.5E-7"),
+				nullable.NewNumber("-1.5E-7", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Number
+				err := n.UnmarshalText(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestNumber_MarshalYAML(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Number
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewNumber("", false),
+				[]byte("null\n"),
+			},
+			{
+				"trailing zero",
+				nullable.NewNumber("1.50", true),
+				[]byte("1.50\n"),
+			},
+			{
+				"beyond float64 precision",
+				nullable.NewNumber("12345678901234567890.123", true),
+				[]byte("12345678901234567890.123\n"),
+			},
+			{
+				"beyond float64 range",
+				nullable.NewNumber("1e400", true),
+				[]byte("1e400\n"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := yaml.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestNumber_UnmarshalYAML(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   *yaml.Node
+			want string
+		}{
+			{
+				"sequence",
+				&yaml.Node{
+					Kind: yaml.SequenceNode,
+					Tag:  "!!seq",
+				},
+				"",
+			},
+			{
+				"int: hex",
+				&yaml.Node{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!int",
+					Value: "0x1f",
+				},
+				`invalid number "0x1f"`,
+			},
+			{
+				"float: infinity",
+				&yaml.Node{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!float",
+					Value: ".inf",
+				},
+				`invalid number ".inf"`,
+			},
+			{
+				"string",
+				&yaml.Node{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!str",
+					Value: "one",
+				},
+				`invalid number "one"`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Number
+				err := n.UnmarshalYAML(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   *yaml.Node
+			want nullable.Number
+		}{
+			{
+				"null",
+				&yaml.Node{
+					Kind: yaml.ScalarNode,
+					Tag:  "!!null",
+				},
+				nullable.NewNumber("", false),
+			},
+			{
+				"int",
+				&yaml.Node{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!int",
+					Value: "-0",
+				},
+				nullable.NewNumber("-0", true),
+			},
+			{
+				"float",
+				&yaml.Node{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!float",
+					Value: "12345678901234567890.123",
+				},
+				nullable.NewNumber("12345678901234567890.123", true),
+			},
+			{
+				"string",
+				&yaml.Node{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!str",
+					Value: "1e400",
+				},
+				nullable.NewNumber("1e400", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				// Start from a valid value, so that the null case shows that !!null resets it.
+				n := nullable.NewNumber("1", true)
+				err := n.UnmarshalYAML(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestNumber_Generate(t *testing.T) {
+	ns := generate[nullable.Number](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Number
+	}{
+		{
+			"null",
+			nullable.NewNumber("", false),
+		},
+		{
+			"0",
+			nullable.NewNumber("0", true),
+		},
+		{
+			"-0",
+			nullable.NewNumber("-0", true),
+		},
+		{
+			"1.50",
+			nullable.NewNumber("1.50", true),
+		},
+		{
+			"beyond float64 precision",
+			nullable.NewNumber("12345678901234567890.123", true),
+		},
+		{
+			"beyond float64 range",
+			nullable.NewNumber("1e400", true),
+		},
+		{
+			"exponent",
+			nullable.NewNumber("-1.5E-7", true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+
+	for _, n := range ns {
+		if n.Valid {
+			_, err := nullable.ParseNumber(string(n.Number))
+			require.NoError(t, err)
+		}
+	}
+}
+
+func FuzzNumber_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Number](f,
+		[]byte(""),
+		[]byte("0"),
+		[]byte("-0"),
+		[]byte("1.50"),
+		[]byte("12345678901234567890.123"),
+		[]byte("NaN"),
+	)
+}
+
+func FuzzNumber_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Number](f,
+		[]byte{},
+		[]byte(`true`),
+		[]byte(`null`),
+		[]byte(`-1.5E-7`),
+		[]byte(`1e400`),
+		[]byte(`"1.50"`),
+	)
+}
+
+func FuzzNumber_UnmarshalText(f *testing.F) {
+	nullabletest.FuzzText[nullable.Number](f,
+		[]byte{},
+		[]byte("0"),
+		[]byte("1.50"),
+		[]byte("1_000"),
+	)
+}
+
+func FuzzNumber_UnmarshalYAML(f *testing.F) {
+	nullabletest.FuzzYAML[nullable.Number](f,
+		[]byte(`null`),
+		[]byte(`~`),
+		[]byte(`0x1f`),
+		[]byte(`.inf`),
+		[]byte(`"1.50"`),
+		[]byte(`12345678901234567890.123`),
+		[]byte(`1e400`),
+	)
+}
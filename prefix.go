@@ -0,0 +1,245 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/netip"
+	"reflect"
+	"strings"
+)
+
+// Prefix represents a nullable IP prefix wrapping netip.Prefix, for PostgreSQL cidr and inet columns.
+//
+// As in inet, the address may have bits set beyond the prefix length, such as 192.0.2.1/24;
+// cidr columns reject such values, so use Masked before storing them there.
+type Prefix struct {
+	Prefix netip.Prefix
+	Valid  bool
+}
+
+// NewPrefix returns a new Prefix.
+func NewPrefix(p netip.Prefix, valid bool) Prefix {
+	return Prefix{
+		Prefix: p,
+		Valid:  valid,
+	}
+}
+
+// NullableString returns the value as a String in CIDR notation, such as "192.0.2.0/24".
+func (n Prefix) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(n.Prefix.String(), true)
+}
+
+// Addr returns the address of the value, or null if invalid.
+func (n Prefix) Addr() Addr {
+	if !n.Valid {
+		return Addr{}
+	}
+
+	return NewAddr(n.Prefix.Addr(), true)
+}
+
+// Masked returns the value with the bits of its address beyond the prefix length cleared,
+// or null if invalid.
+func (n Prefix) Masked() Prefix {
+	if !n.Valid {
+		return Prefix{}
+	}
+
+	return NewPrefix(n.Prefix.Masked(), true)
+}
+
+// Contains reports whether the value contains a, or returns null if either is invalid.
+// An IPv4 prefix never contains an IPv6 address, including an IPv4-mapped one; use Addr.Unmap first.
+func (n Prefix) Contains(a Addr) Bool {
+	if !n.Valid || !a.Valid {
+		return NewBool(false, false)
+	}
+
+	return NewBool(n.Prefix.Contains(a.Addr), true)
+}
+
+// Overlaps reports whether the value and p have an address in common, or returns null if either is invalid.
+func (n Prefix) Overlaps(p Prefix) Bool {
+	if !n.Valid || !p.Valid {
+		return NewBool(false, false)
+	}
+
+	return NewBool(n.Prefix.Overlaps(p.Prefix), true)
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a string in CIDR notation, or nil if invalid.
+func (n Prefix) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	if !n.Prefix.IsValid() {
+		return nil, errInvalidPrefix
+	}
+
+	return n.Prefix.String(), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts one of the following:
+//   - string (CIDR notation, or an IP address as a single-address prefix, as PostgreSQL inet columns return)
+//   - []byte (as string, or 4 or 16 bytes in network byte order as a single-address prefix if it is neither)
+//   - nil
+func (n *Prefix) Scan(src any) error {
+	if src == nil {
+		*n = Prefix{}
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case string:
+		return n.parseInet(v)
+
+	case []byte:
+		if p, err := parseInetPrefix(string(v)); err == nil {
+			*n = NewPrefix(p, true)
+
+			return nil
+		}
+
+		if a, ok := netip.AddrFromSlice(v); ok {
+			*n = NewPrefix(netip.PrefixFrom(a, a.BitLen()), true)
+
+			return nil
+		}
+
+		return n.parseInet(string(v))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in CIDR notation, or null if invalid.
+func (n Prefix) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	if !n.Prefix.IsValid() {
+		return nil, errInvalidPrefix
+	}
+
+	return json.Marshal(n.Prefix.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string in CIDR notation, or null.
+func (n *Prefix) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = Prefix{}
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	return n.parse(s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns the value in CIDR notation, or an empty text if invalid.
+func (n Prefix) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	if !n.Prefix.IsValid() {
+		return nil, errInvalidPrefix
+	}
+
+	return []byte(n.Prefix.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts CIDR notation, or an empty text as null.
+func (n *Prefix) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*n = Prefix{}
+
+		return nil
+	}
+
+	return n.parse(string(b))
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (the IPv4 or IPv6 default route, a private network,
+// a single address or a prefix with host bits set), or a random prefix.
+func (Prefix) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(Prefix{})
+	}
+
+	if s, ok := generateEdge(r, "0.0.0.0/0", "::/0", "10.0.0.0/8", "2001:db8::/32", "192.0.2.1/32", "192.0.2.1/24"); ok {
+		return reflect.ValueOf(NewPrefix(netip.MustParsePrefix(s), true))
+	}
+
+	a := generateNetipAddr(r)
+
+	return reflect.ValueOf(NewPrefix(netip.PrefixFrom(a, r.Intn(a.BitLen()+1)), true))
+}
+
+var errInvalidPrefix = errors.New("invalid IP prefix")
+
+func (n *Prefix) parse(s string) error {
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	*n = NewPrefix(p, true)
+
+	return nil
+}
+
+func (n *Prefix) parseInet(s string) error {
+	p, err := parseInetPrefix(s)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	*n = NewPrefix(p, true)
+
+	return nil
+}
+
+// parseInetPrefix parses s in CIDR notation, or as an IP address without a zone,
+// which PostgreSQL inet columns return for single-address prefixes.
+func parseInetPrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		return netip.ParsePrefix(s)
+	}
+
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if a.Zone() != "" {
+		return netip.Prefix{}, fmt.Errorf("netip.ParsePrefix(%q): IPv6 zones cannot be present in a prefix", s)
+	}
+
+	return netip.PrefixFrom(a, a.BitLen()), nil
+}
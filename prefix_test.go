@@ -0,0 +1,613 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestPrefix(t *testing.T) {
+	var n nullable.Prefix
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextMarshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextUnmarshaler)(nil), &n)
+}
+
+func TestPrefix_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Prefix]{
+		Valid: []nullable.Prefix{
+			nullable.NewPrefix(netip.MustParsePrefix("0.0.0.0/0"), true),
+			nullable.NewPrefix(netip.MustParsePrefix("10.0.0.0/8"), true),
+			nullable.NewPrefix(netip.MustParsePrefix("192.0.2.1/24"), true),
+			nullable.NewPrefix(netip.MustParsePrefix("192.0.2.1/32"), true),
+			nullable.NewPrefix(netip.MustParsePrefix("::/0"), true),
+			nullable.NewPrefix(netip.MustParsePrefix("2001:db8::/32"), true),
+		},
+		InvalidScan: []any{
+			"",
+			"192.0.2.0/33",
+			"fe80::1%eth0",
+			[]byte("192.0.2"),
+			int64(1),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`0`),
+			[]byte(`""`),
+			[]byte(`"192.0.2.1"`),
+			[]byte(`"192.0.2.0/-1"`),
+		},
+	})
+}
+
+func TestPrefix_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Prefix
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.0/24"), false),
+				nullable.NewString("", false),
+			},
+			{
+				"IPv4",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.0/24"), true),
+				nullable.NewString("192.0.2.0/24", true),
+			},
+			{
+				"IPv6",
+				nullable.NewPrefix(netip.MustParsePrefix("2001:0db8::/32"), true),
+				nullable.NewString("2001:db8::/32", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.NullableString())
+			})
+		}
+	})
+}
+
+func TestPrefix_Addr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Prefix
+			want nullable.Addr
+		}{
+			{
+				"null",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.1/24"), false),
+				nullable.Addr{},
+			},
+			{
+				"valid",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.1/24"), true),
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Addr())
+			})
+		}
+	})
+}
+
+func TestPrefix_Masked(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Prefix
+			want nullable.Prefix
+		}{
+			{
+				"null",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.1/24"), false),
+				nullable.Prefix{},
+			},
+			{
+				"host bits set",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.1/24"), true),
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.0/24"), true),
+			},
+			{
+				"masked",
+				nullable.NewPrefix(netip.MustParsePrefix("2001:db8::/32"), true),
+				nullable.NewPrefix(netip.MustParsePrefix("2001:db8::/32"), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Masked())
+			})
+		}
+	})
+}
+
+func TestPrefix_Contains(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Prefix
+			addr nullable.Addr
+			want nullable.Bool
+		}{
+			{
+				"null prefix",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.0/24"), false),
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), true),
+				nullable.NewBool(false, false),
+			},
+			{
+				"null address",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.0/24"), true),
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.1"), false),
+				nullable.NewBool(false, false),
+			},
+			{
+				"contained",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.0/24"), true),
+				nullable.NewAddr(netip.MustParseAddr("192.0.2.255"), true),
+				nullable.NewBool(true, true),
+			},
+			{
+				"not contained",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.0/24"), true),
+				nullable.NewAddr(netip.MustParseAddr("192.0.3.0"), true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"IPv4-mapped IPv6",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.0/24"), true),
+				nullable.NewAddr(netip.MustParseAddr("::ffff:192.0.2.1"), true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"IPv6",
+				nullable.NewPrefix(netip.MustParsePrefix("2001:db8::/32"), true),
+				nullable.NewAddr(netip.MustParseAddr("2001:db8:ffff::1"), true),
+				nullable.NewBool(true, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Contains(tc.addr))
+			})
+		}
+	})
+}
+
+func TestPrefix_Overlaps(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Prefix
+			p    nullable.Prefix
+			want nullable.Bool
+		}{
+			{
+				"null",
+				nullable.NewPrefix(netip.MustParsePrefix("10.0.0.0/8"), true),
+				nullable.NewPrefix(netip.MustParsePrefix("10.1.0.0/16"), false),
+				nullable.NewBool(false, false),
+			},
+			{
+				"overlapping",
+				nullable.NewPrefix(netip.MustParsePrefix("10.0.0.0/8"), true),
+				nullable.NewPrefix(netip.MustParsePrefix("10.1.0.0/16"), true),
+				nullable.NewBool(true, true),
+			},
+			{
+				"disjoint",
+				nullable.NewPrefix(netip.MustParsePrefix("10.0.0.0/8"), true),
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.0/24"), true),
+				nullable.NewBool(false, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Overlaps(tc.p))
+			})
+		}
+	})
+}
+
+func TestPrefix_Value(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := nullable.NewPrefix(netip.Prefix{}, true).Value()
+		require.ErrorContains(t, err, "invalid IP prefix")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Prefix
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewPrefix(netip.Prefix{}, false),
+				nil,
+			},
+			{
+				"IPv4",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.1/24"), true),
+				"192.0.2.1/24",
+			},
+			{
+				"IPv6",
+				nullable.NewPrefix(netip.MustParsePrefix("2001:db8::/32"), true),
+				"2001:db8::/32",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestPrefix_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"int64",
+				int64(1),
+				"unsupported source type: int64",
+			},
+			{
+				"string: empty",
+				"",
+				"invalid source",
+			},
+			{
+				"string: prefix length out of range",
+				"192.0.2.0/33",
+				"invalid source",
+			},
+			{
+				"string: zone",
+				"fe80::1%eth0",
+				"invalid source: netip.ParsePrefix(\"fe80::1%eth0\"): IPv6 zones cannot be present in a prefix",
+			},
+			{
+				"[]byte: 5 bytes",
+				[]byte{192, 0, 2, 1, 0},
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Prefix
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.Prefix
+		}{
+			{
+				"nil",
+				nil,
+				nullable.Prefix{},
+			},
+			{
+				"string: cidr",
+				"192.0.2.0/24",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.0/24"), true),
+			},
+			{
+				"string: inet with host bits set",
+				"192.0.2.1/24",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.1/24"), true),
+			},
+			{
+				"string: inet single address",
+				"192.0.2.1",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.1/32"), true),
+			},
+			{
+				"[]byte: text",
+				[]byte("2001:db8::/32"),
+				nullable.NewPrefix(netip.MustParsePrefix("2001:db8::/32"), true),
+			},
+			{
+				"[]byte: 4 bytes",
+				[]byte{192, 0, 2, 1},
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.1/32"), true),
+			},
+			{
+				"[]byte: 16 bytes",
+				[]byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+				nullable.NewPrefix(netip.MustParsePrefix("2001:db8::1/128"), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Prefix
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestPrefix_MarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := nullable.NewPrefix(netip.Prefix{}, true).MarshalJSON()
+		require.ErrorContains(t, err, "invalid IP prefix")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Prefix
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewPrefix(netip.Prefix{}, false),
+				[]byte(`null`),
+			},
+			{
+				"IPv4",
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.0/24"), true),
+				[]byte(`"192.0.2.0/24"`),
+			},
+			{
+				"IPv6",
+				nullable.NewPrefix(netip.MustParsePrefix("2001:db8::/32"), true),
+				[]byte(`"2001:db8::/32"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestPrefix_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"number",
+				[]byte(`24`),
+				"cannot unmarshal number",
+			},
+			{
+				"string: empty",
+				[]byte(`""`),
+				"invalid source",
+			},
+			{
+				"string: address",
+				[]byte(`"192.0.2.1"`),
+				"invalid source: netip.ParsePrefix(\"192.0.2.1\"): no '/'",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Prefix
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Prefix
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.Prefix{},
+			},
+			{
+				"IPv4",
+				[]byte(`"192.0.2.0/24"`),
+				nullable.NewPrefix(netip.MustParsePrefix("192.0.2.0/24"), true),
+			},
+			{
+				"IPv6",
+				[]byte(`"2001:DB8::/32"`),
+				nullable.NewPrefix(netip.MustParsePrefix("2001:db8::/32"), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Prefix
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestPrefix_MarshalText(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Prefix
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewPrefix(netip.Prefix{}, false),
+				[]byte{},
+			},
+			{
+				"IPv4",
+				nullable.NewPrefix(netip.MustParsePrefix("10.0.0.0/8"), true),
+				[]byte("10.0.0.0/8"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := tc.in.MarshalText()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestPrefix_UnmarshalText(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		var n nullable.Prefix
+		err := n.UnmarshalText([]byte("10.0.0.0"))
+		require.ErrorContains(t, err, "invalid source")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Prefix
+		}{
+			{
+				"empty",
+				[]byte{},
+				nullable.Prefix{},
+			},
+			{
+				"IPv4",
+				[]byte("10.0.0.0/8"),
+				nullable.NewPrefix(netip.MustParsePrefix("10.0.0.0/8"), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Prefix
+				err := n.UnmarshalText(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestPrefix_Generate(t *testing.T) {
+	ns := generate[nullable.Prefix](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Prefix
+	}{
+		{
+			"null",
+			nullable.Prefix{},
+		},
+		{
+			"IPv4 default route",
+			nullable.NewPrefix(netip.MustParsePrefix("0.0.0.0/0"), true),
+		},
+		{
+			"IPv6 default route",
+			nullable.NewPrefix(netip.MustParsePrefix("::/0"), true),
+		},
+		{
+			"private network",
+			nullable.NewPrefix(netip.MustParsePrefix("10.0.0.0/8"), true),
+		},
+		{
+			"documentation network",
+			nullable.NewPrefix(netip.MustParsePrefix("2001:db8::/32"), true),
+		},
+		{
+			"single address",
+			nullable.NewPrefix(netip.MustParsePrefix("192.0.2.1/32"), true),
+		},
+		{
+			"host bits set",
+			nullable.NewPrefix(netip.MustParsePrefix("192.0.2.1/24"), true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzPrefix_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Prefix](f,
+		[]byte(""),
+		[]byte("192.0.2.0/24"),
+		[]byte("192.0.2.1"),
+		[]byte("2001:db8::/32"),
+		[]byte("fe80::1%eth0"),
+		[]byte{192, 0, 2, 1},
+	)
+}
+
+func FuzzPrefix_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Prefix](f,
+		[]byte(`0`),
+		[]byte(`null`),
+		[]byte(`""`),
+		[]byte(`"192.0.2.0/24"`),
+		[]byte(`"::/0"`),
+	)
+}
+
+func FuzzPrefix_UnmarshalText(f *testing.F) {
+	nullabletest.FuzzText[nullable.Prefix](f,
+		[]byte{},
+		[]byte("10.0.0.0/8"),
+		[]byte("2001:db8::/32"),
+		[]byte("10.0.0.0"),
+	)
+}
@@ -0,0 +1,23 @@
+package nullable
+
+import (
+	"math/rand"
+)
+
+// generateNull reports whether a Generate method should return null,
+// which it does for 1 in 8 values.
+func generateNull(r *rand.Rand) bool {
+	return r.Intn(8) == 0
+}
+
+// generateEdge returns one of edges, and true, for 1 in 4 values,
+// so that edge cases are generated far more often than uniform sampling would.
+func generateEdge[T any](r *rand.Rand, edges ...T) (T, bool) {
+	if r.Intn(4) != 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	return edges[r.Intn(len(edges))], true
+}
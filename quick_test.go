@@ -0,0 +1,27 @@
+package nullable_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+)
+
+// generate returns n values of T generated by quick.Value from a fixed seed.
+func generate[T quick.Generator](t *testing.T, n int) []T {
+	t.Helper()
+
+	r := rand.New(rand.NewSource(1))
+
+	ns := make([]T, n)
+	for i := range ns {
+		v, ok := quick.Value(reflect.TypeFor[T](), r)
+		require.True(t, ok)
+
+		ns[i] = v.Interface().(T)
+	}
+
+	return ns
+}
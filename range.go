@@ -0,0 +1,766 @@
+package nullable
+
+import (
+	"bytes"
+	"cmp"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m0t0k1ch1-go/bigutil/v3"
+)
+
+// RangeBound is the constraint satisfied by the bound types of Range:
+// Int32, Int64, Uint64, Uint256, Date, Time and Timestamp.
+type RangeBound[T any] interface {
+	driver.Valuer
+	json.Marshaler
+
+	Generate(r *rand.Rand, size int) reflect.Value
+
+	// boundValid reports whether the bound is valid; a null bound is unbounded.
+	boundValid() bool
+
+	// compareBound compares two valid bounds, returning -1, 0 or +1.
+	compareBound(u T) int
+
+	// formatBound returns a valid bound in PostgreSQL range literal syntax, before quoting.
+	formatBound() string
+
+	// parseBound parses an unquoted bound of a PostgreSQL range literal.
+	parseBound(s string) (T, error)
+
+	// nextBound returns the successor of a valid bound for discrete types,
+	// or false for continuous types and on overflow.
+	nextBound() (T, bool)
+}
+
+// Range represents a nullable range of T mirroring a PostgreSQL range type,
+// such as int8range for Range[Int64], numrange for Range[Uint256] and tstzrange for Range[Timestamp].
+// A null Lower or Upper means that the range is unbounded on that side.
+//
+// Ranges of discrete types (Int32, Int64, Uint64 and Date) are canonicalized
+// to an inclusive lower and exclusive upper bound, as PostgreSQL does.
+type Range[T RangeBound[T]] struct {
+	Lower          T
+	Upper          T
+	LowerInclusive bool
+	UpperInclusive bool
+	Empty          bool
+	Valid          bool
+}
+
+// NewRange returns a new Range.
+// It canonicalizes the bounds, and returns an empty range if they enclose no value,
+// or an error if lower is after upper. It returns null if valid is false, whatever the bounds.
+func NewRange[T RangeBound[T]](lower T, upper T, lowerInclusive bool, upperInclusive bool, valid bool) (Range[T], error) {
+	n := Range[T]{
+		Lower:          lower,
+		Upper:          upper,
+		LowerInclusive: lowerInclusive,
+		UpperInclusive: upperInclusive,
+		Valid:          valid,
+	}
+
+	if err := n.normalize(); err != nil {
+		return Range[T]{}, err
+	}
+
+	return n, nil
+}
+
+// NewEmptyRange returns a new valid empty Range.
+func NewEmptyRange[T RangeBound[T]]() Range[T] {
+	return Range[T]{
+		Empty: true,
+		Valid: true,
+	}
+}
+
+// NullableString returns the value as a String in PostgreSQL range literal syntax.
+func (n Range[T]) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(n.format(), true)
+}
+
+// Contains reports whether v is within the range, or returns null if either is invalid.
+func (n Range[T]) Contains(v T) Bool {
+	if !n.Valid || !v.boundValid() {
+		return NewBool(false, false)
+	}
+
+	if n.Empty {
+		return NewBool(false, true)
+	}
+
+	return NewBool(compareLower(n, v) <= 0 && compareUpper(n, v) >= 0, true)
+}
+
+// Overlaps reports whether the range and u have a value in common, or returns null if either is invalid.
+func (n Range[T]) Overlaps(u Range[T]) Bool {
+	if !n.Valid || !u.Valid {
+		return NewBool(false, false)
+	}
+
+	return NewBool(!n.Intersect(u).Empty, true)
+}
+
+// Intersect returns the range of the values in both the range and u, or null if either is invalid.
+func (n Range[T]) Intersect(u Range[T]) Range[T] {
+	if !n.Valid || !u.Valid {
+		return Range[T]{}
+	}
+
+	if n.Empty || u.Empty {
+		return NewEmptyRange[T]()
+	}
+
+	m := n
+	if compareLowerBounds(u, n) > 0 {
+		m.Lower, m.LowerInclusive = u.Lower, u.LowerInclusive
+	}
+	if compareUpperBounds(u, n) < 0 {
+		m.Upper, m.UpperInclusive = u.Upper, u.UpperInclusive
+	}
+
+	if err := m.normalize(); err != nil {
+		return NewEmptyRange[T]()
+	}
+
+	return m
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a string in PostgreSQL range literal syntax, or nil if invalid.
+func (n Range[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.format(), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts:
+//   - string (PostgreSQL range literal)
+//   - []byte (PostgreSQL range literal)
+//   - nil
+func (n *Range[T]) Scan(src any) error {
+	if src == nil {
+		*n = Range[T]{}
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case string:
+		return n.parse(v)
+
+	case []byte:
+		return n.parse(string(v))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// rangeJSON is the JSON encoding of a non-empty Range.
+type rangeJSON struct {
+	Lower  json.RawMessage `json:"lower"`
+	Upper  json.RawMessage `json:"upper"`
+	Bounds *string         `json:"bounds,omitempty"`
+	Empty  bool            `json:"empty,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON object {"lower": ..., "upper": ..., "bounds": "[)"}
+// with each bound in its own JSON encoding and null for unbounded,
+// {"empty": true} if empty, or null if invalid.
+func (n Range[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	if n.Empty {
+		return []byte(`{"empty":true}`), nil
+	}
+
+	lower, err := n.Lower.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	upper, err := n.Upper.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := n.bounds()
+
+	return json.Marshal(rangeJSON{
+		Lower:  lower,
+		Upper:  upper,
+		Bounds: &bounds,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON object as returned by MarshalJSON, where "bounds" defaults to "[)", or null.
+func (n *Range[T]) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = Range[T]{}
+
+		return nil
+	}
+
+	var v rangeJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	if v.Empty {
+		*n = NewEmptyRange[T]()
+
+		return nil
+	}
+
+	bounds := "[)"
+	if v.Bounds != nil {
+		bounds = *v.Bounds
+	}
+
+	if len(bounds) != 2 || !strings.Contains("[(", bounds[:1]) || !strings.Contains("])", bounds[1:]) {
+		return fmt.Errorf("invalid JSON: invalid bounds %q", bounds)
+	}
+
+	var m Range[T]
+	for _, bound := range []struct {
+		raw json.RawMessage
+		dst *T
+	}{
+		{v.Lower, &m.Lower},
+		{v.Upper, &m.Upper},
+	} {
+		if len(bound.raw) == 0 {
+			continue
+		}
+
+		if err := json.Unmarshal(bound.raw, bound.dst); err != nil {
+			return err
+		}
+	}
+
+	m.LowerInclusive, m.UpperInclusive, m.Valid = bounds[0] == '[', bounds[1] == ']', true
+
+	if err := m.normalize(); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	*n = m
+
+	return nil
+}
+
+// Generate implements quick.Generator.
+// It returns null, the empty range, a range unbounded on either or both sides,
+// or a range between two random bounds generated by T.
+func (Range[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(Range[T]{})
+	}
+
+	if r.Intn(8) == 0 {
+		return reflect.ValueOf(NewEmptyRange[T]())
+	}
+
+	var zero T
+
+	lower := zero.Generate(r, size).Interface().(T)
+	upper := zero.Generate(r, size).Interface().(T)
+	if lower.boundValid() && upper.boundValid() && lower.compareBound(upper) > 0 {
+		lower, upper = upper, lower
+	}
+
+	n, _ := NewRange(lower, upper, r.Intn(2) == 0, r.Intn(2) == 0, true)
+
+	return reflect.ValueOf(n)
+}
+
+var errRangeBoundOrder = errors.New("range lower bound must be less than or equal to range upper bound")
+
+// normalize canonicalizes the bounds of a valid range as PostgreSQL does,
+// making it empty if it contains no value.
+// It returns an error if the lower bound is after the upper bound.
+func (n *Range[T]) normalize() error {
+	if !n.Valid {
+		*n = Range[T]{}
+
+		return nil
+	}
+
+	if n.Empty {
+		*n = NewEmptyRange[T]()
+
+		return nil
+	}
+
+	if n.Lower.boundValid() && n.Upper.boundValid() && n.Lower.compareBound(n.Upper) > 0 {
+		return errRangeBoundOrder
+	}
+
+	var zero T
+
+	if !n.Lower.boundValid() {
+		n.Lower, n.LowerInclusive = zero, false
+	} else if !n.LowerInclusive {
+		if next, ok := n.Lower.nextBound(); ok {
+			n.Lower, n.LowerInclusive = next, true
+		}
+	}
+
+	if !n.Upper.boundValid() {
+		n.Upper, n.UpperInclusive = zero, false
+	} else if n.UpperInclusive {
+		if next, ok := n.Upper.nextBound(); ok {
+			n.Upper, n.UpperInclusive = next, false
+		}
+	}
+
+	if !n.Lower.boundValid() || !n.Upper.boundValid() {
+		return nil
+	}
+
+	if c := n.Lower.compareBound(n.Upper); c > 0 || (c == 0 && !(n.LowerInclusive && n.UpperInclusive)) {
+		*n = NewEmptyRange[T]()
+	}
+
+	return nil
+}
+
+func (n Range[T]) bounds() string {
+	lower, upper := "(", ")"
+	if n.LowerInclusive {
+		lower = "["
+	}
+	if n.UpperInclusive {
+		upper = "]"
+	}
+
+	return lower + upper
+}
+
+func (n Range[T]) format() string {
+	if n.Empty {
+		return "empty"
+	}
+
+	var b strings.Builder
+
+	bounds := n.bounds()
+
+	b.WriteByte(bounds[0])
+	if n.Lower.boundValid() {
+		b.WriteString(quoteRangeBound(n.Lower.formatBound()))
+	}
+	b.WriteByte(',')
+	if n.Upper.boundValid() {
+		b.WriteString(quoteRangeBound(n.Upper.formatBound()))
+	}
+	b.WriteByte(bounds[1])
+
+	return b.String()
+}
+
+func (n *Range[T]) parse(s string) error {
+	s = strings.TrimSpace(s)
+
+	if strings.EqualFold(s, "empty") {
+		*n = NewEmptyRange[T]()
+
+		return nil
+	}
+
+	if len(s) < 2 || !strings.Contains("[(", s[:1]) || !strings.Contains("])", s[len(s)-1:]) {
+		return fmt.Errorf("invalid source: %q is not a range literal", s)
+	}
+
+	bounds, err := splitRangeBounds(s[1 : len(s)-1])
+	if err != nil {
+		return fmt.Errorf("invalid source: %q: %w", s, err)
+	}
+
+	m := Range[T]{
+		LowerInclusive: s[0] == '[',
+		UpperInclusive: s[len(s)-1] == ']',
+		Valid:          true,
+	}
+
+	var zero T
+	for i, dst := range []*T{&m.Lower, &m.Upper} {
+		if bounds[i] == nil {
+			continue
+		}
+
+		if *dst, err = zero.parseBound(*bounds[i]); err != nil {
+			return fmt.Errorf("invalid source: %q: %w", s, err)
+		}
+	}
+
+	if err := m.normalize(); err != nil {
+		return fmt.Errorf("invalid source: %q: %w", s, err)
+	}
+
+	*n = m
+
+	return nil
+}
+
+// splitRangeBounds splits the inside of a range literal into its unquoted lower and upper bounds,
+// nil for unbounded.
+func splitRangeBounds(s string) ([2]*string, error) {
+	var (
+		bounds  [2]*string
+		b       strings.Builder
+		i       int
+		quoted  bool
+		present bool
+	)
+
+	for j := 0; j < len(s); j++ {
+		switch c := s[j]; {
+
+		case c == '\\':
+			if j+1 == len(s) {
+				return bounds, errors.New("unterminated escape")
+			}
+			j++
+			b.WriteByte(s[j])
+			present = true
+
+		case c == '"':
+			if quoted && j+1 < len(s) && s[j+1] == '"' {
+				j++
+				b.WriteByte('"')
+			} else {
+				quoted = !quoted
+			}
+			present = true
+
+		case c == ',' && !quoted:
+			if i == 1 {
+				return bounds, errors.New("too many bounds")
+			}
+			if present {
+				bounds[i] = new(b.String())
+			}
+			b.Reset()
+			i, present = i+1, false
+
+		default:
+			b.WriteByte(c)
+			present = true
+		}
+	}
+
+	if quoted {
+		return bounds, errors.New("unterminated quote")
+	}
+	if i != 1 {
+		return bounds, errors.New("too few bounds")
+	}
+	if present {
+		bounds[1] = new(b.String())
+	}
+
+	return bounds, nil
+}
+
+// quoteRangeBound double-quotes s if it would otherwise not read back as a single bound.
+func quoteRangeBound(s string) string {
+	if s != "" && !strings.ContainsAny(s, "\"\\,()[] \t\n\r\v\f") {
+		return s
+	}
+
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func (n Int32) boundValid() bool {
+	return n.Valid
+}
+
+func (n Int32) compareBound(u Int32) int {
+	return cmp.Compare(n.Int32, u.Int32)
+}
+
+func (n Int32) formatBound() string {
+	return strconv.FormatInt(int64(n.Int32), 10)
+}
+
+func (Int32) parseBound(s string) (Int32, error) {
+	i, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+	if err != nil {
+		return Int32{}, err
+	}
+
+	return NewInt32(int32(i), true), nil
+}
+
+func (n Int32) nextBound() (Int32, bool) {
+	if n.Int32 == math.MaxInt32 {
+		return n, false
+	}
+
+	return NewInt32(n.Int32+1, true), true
+}
+
+func (n Int64) boundValid() bool {
+	return n.Valid
+}
+
+func (n Int64) compareBound(u Int64) int {
+	return cmp.Compare(n.Int64, u.Int64)
+}
+
+func (n Int64) formatBound() string {
+	return strconv.FormatInt(n.Int64, 10)
+}
+
+func (Int64) parseBound(s string) (Int64, error) {
+	i, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return Int64{}, err
+	}
+
+	return NewInt64(i, true), nil
+}
+
+func (n Int64) nextBound() (Int64, bool) {
+	if n.Int64 == math.MaxInt64 {
+		return n, false
+	}
+
+	return NewInt64(n.Int64+1, true), true
+}
+
+func (n Uint64) boundValid() bool {
+	return n.Valid
+}
+
+func (n Uint64) compareBound(u Uint64) int {
+	return cmp.Compare(n.Uint64, u.Uint64)
+}
+
+func (n Uint64) formatBound() string {
+	return strconv.FormatUint(n.Uint64, 10)
+}
+
+func (Uint64) parseBound(s string) (Uint64, error) {
+	i, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return Uint64{}, err
+	}
+
+	return NewUint64(i, true), nil
+}
+
+func (n Uint64) nextBound() (Uint64, bool) {
+	if n.Uint64 == math.MaxUint64 {
+		return n, false
+	}
+
+	return NewUint64(n.Uint64+1, true), true
+}
+
+func (n Uint256) boundValid() bool {
+	return n.Valid
+}
+
+func (n Uint256) compareBound(u Uint256) int {
+	return n.Uint256.BigInt().Cmp(u.Uint256.BigInt())
+}
+
+func (n Uint256) formatBound() string {
+	return n.Uint256.BigInt().Text(10)
+}
+
+func (Uint256) parseBound(s string) (Uint256, error) {
+	x, ok := new(big.Int).SetString(strings.TrimSpace(s), 10)
+	if !ok {
+		return Uint256{}, fmt.Errorf("%q is not a decimal integer", s)
+	}
+
+	x256, err := bigutil.NewUint256(x)
+	if err != nil {
+		return Uint256{}, err
+	}
+
+	return NewUint256(x256, true), nil
+}
+
+func (n Uint256) nextBound() (Uint256, bool) {
+	return n, false
+}
+
+func (n Date) boundValid() bool {
+	return n.Valid
+}
+
+func (n Date) compareBound(u Date) int {
+	return n.Compare(u)
+}
+
+func (n Date) formatBound() string {
+	return n.format()
+}
+
+func (Date) parseBound(s string) (Date, error) {
+	var n Date
+	if err := n.parse(strings.TrimSpace(s)); err != nil {
+		return Date{}, err
+	}
+
+	return n, nil
+}
+
+func (n Date) nextBound() (Date, bool) {
+	next := n.AddDays(1)
+	if !next.Valid {
+		return n, false
+	}
+
+	return next, true
+}
+
+// postgresTimestamptzLayouts are the layouts of PostgreSQL timestamptz output in the ISO style.
+var postgresTimestamptzLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	time.RFC3339Nano,
+}
+
+func parsePostgresTimestamptz(s string) (time.Time, error) {
+	for _, layout := range postgresTimestamptzLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%q is not a timestamptz", s)
+}
+
+func (n Time) boundValid() bool {
+	return n.Valid
+}
+
+func (n Time) compareBound(u Time) int {
+	return n.Time.Compare(u.Time)
+}
+
+// formatBound truncates the bound to its precision, as Value does.
+func (n Time) formatBound() string {
+	return n.Time.UTC().Truncate(n.Precision()).Format(time.RFC3339Nano)
+}
+
+func (Time) parseBound(s string) (Time, error) {
+	t, err := parsePostgresTimestamptz(strings.TrimSpace(s))
+	if err != nil {
+		return Time{}, err
+	}
+
+	return NewTime(t, true), nil
+}
+
+func (n Time) nextBound() (Time, bool) {
+	return n, false
+}
+
+func (n Timestamp) boundValid() bool {
+	return n.Valid
+}
+
+func (n Timestamp) compareBound(u Timestamp) int {
+	return cmp.Compare(n.Timestamp.Unix(), u.Timestamp.Unix())
+}
+
+func (n Timestamp) formatBound() string {
+	return n.Time().formatBound()
+}
+
+func (Timestamp) parseBound(s string) (Timestamp, error) {
+	t, err := parsePostgresTimestamptz(strings.TrimSpace(s))
+	if err != nil {
+		return Timestamp{}, err
+	}
+
+	return NewTimestampFromTime(t, true), nil
+}
+
+func (n Timestamp) nextBound() (Timestamp, bool) {
+	return n, false
+}
+
+// compareLower compares the lower bound of a non-empty range with a valid value.
+func compareLower[T RangeBound[T]](n Range[T], v T) int {
+	if !n.Lower.boundValid() {
+		return -1
+	}
+
+	if c := n.Lower.compareBound(v); c != 0 || n.LowerInclusive {
+		return c
+	}
+
+	return 1
+}
+
+// compareUpper compares the upper bound of a non-empty range with a valid value.
+func compareUpper[T RangeBound[T]](n Range[T], v T) int {
+	if !n.Upper.boundValid() {
+		return 1
+	}
+
+	if c := n.Upper.compareBound(v); c != 0 || n.UpperInclusive {
+		return c
+	}
+
+	return -1
+}
+
+// compareLowerBounds compares the lower bounds of two non-empty ranges, with unbounded first.
+func compareLowerBounds[T RangeBound[T]](n Range[T], u Range[T]) int {
+	if !n.Lower.boundValid() || !u.Lower.boundValid() {
+		return compareValid(n.Lower.boundValid(), u.Lower.boundValid())
+	}
+
+	if c := n.Lower.compareBound(u.Lower); c != 0 {
+		return c
+	}
+
+	// An exclusive lower bound starts after an inclusive one.
+	return compareValid(u.LowerInclusive, n.LowerInclusive)
+}
+
+// compareUpperBounds compares the upper bounds of two non-empty ranges, with unbounded last.
+func compareUpperBounds[T RangeBound[T]](n Range[T], u Range[T]) int {
+	if !n.Upper.boundValid() || !u.Upper.boundValid() {
+		return -compareValid(n.Upper.boundValid(), u.Upper.boundValid())
+	}
+
+	if c := n.Upper.compareBound(u.Upper); c != 0 {
+		return c
+	}
+
+	// An exclusive upper bound ends before an inclusive one.
+	return compareValid(n.UpperInclusive, u.UpperInclusive)
+}
@@ -0,0 +1,792 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m0t0k1ch1-go/bigutil/v3"
+	"github.com/m0t0k1ch1-go/timeutil/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+var (
+	int64Null = nullable.NewInt64(0, false)
+
+	// int64Range returns the canonical range [lower, upper), where math.MinInt64 and math.MaxInt64 mean unbounded.
+	int64Range = func(lower int64, upper int64) nullable.Range[nullable.Int64] {
+		return mustNewRange(
+			nullable.NewInt64(lower, lower != math.MinInt64),
+			nullable.NewInt64(upper, upper != math.MaxInt64),
+			true, false, true,
+		)
+	}
+)
+
+// mustNewRange returns nullable.NewRange(lower, upper, lowerInclusive, upperInclusive, valid), panicking on error.
+func mustNewRange[T nullable.RangeBound[T]](lower T, upper T, lowerInclusive bool, upperInclusive bool, valid bool) nullable.Range[T] {
+	n, err := nullable.NewRange(lower, upper, lowerInclusive, upperInclusive, valid)
+	if err != nil {
+		panic(err)
+	}
+
+	return n
+}
+
+func TestRange(t *testing.T) {
+	var n nullable.Range[nullable.Int64]
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestRange_Conformance(t *testing.T) {
+	t.Run("Int64", func(t *testing.T) {
+		nullabletest.RunConformance(t, nullabletest.Spec[nullable.Range[nullable.Int64]]{
+			Valid: []nullable.Range[nullable.Int64]{
+				nullable.NewEmptyRange[nullable.Int64](),
+				int64Range(1, 10),
+				int64Range(math.MinInt64, 10),
+				int64Range(1, math.MaxInt64),
+				int64Range(math.MinInt64, math.MaxInt64),
+			},
+			InvalidScan: []any{
+				int64(0),
+				"",
+				"[1,10",
+				"[10,1)",
+			},
+			InvalidJSON: [][]byte{
+				[]byte(`"[1,10)"`),
+				[]byte(`{"lower":10,"upper":1}`),
+				[]byte(`{"lower":1,"upper":10,"bounds":"[["}`),
+			},
+		})
+	})
+
+	t.Run("Uint256", func(t *testing.T) {
+		nullabletest.RunConformance(t, nullabletest.Spec[nullable.Range[nullable.Uint256]]{
+			Valid: []nullable.Range[nullable.Uint256]{
+				mustNewRange(
+					nullable.NewUint256(bigutil.NewUint256FromUint64(0), true),
+					nullable.NewUint256(bigutil.MustNewUint256(maxUint256), true),
+					false, true, true,
+				),
+			},
+			InvalidScan: []any{
+				"[-1,0)",
+			},
+		})
+	})
+
+	t.Run("Date", func(t *testing.T) {
+		nullabletest.RunConformance(t, nullabletest.Spec[nullable.Range[nullable.Date]]{
+			Valid: []nullable.Range[nullable.Date]{
+				mustNewRange(nullable.NewDate(2009, time.January, 3, true), nullable.NewDate(0, 0, 0, false), true, false, true),
+			},
+			InvalidScan: []any{
+				"[2009-01-03 18:15:05,)",
+			},
+		})
+	})
+
+	t.Run("Time", func(t *testing.T) {
+		nullabletest.RunConformance(t, nullabletest.Spec[nullable.Range[nullable.Time]]{
+			Valid: []nullable.Range[nullable.Time]{
+				mustNewRange(nullable.NewTime(genesis, true), nullable.NewTime(genesisNano.Truncate(time.Microsecond).Add(time.Hour), true), true, true, true),
+			},
+			InvalidScan: []any{
+				"[infinity,)",
+			},
+		})
+	})
+
+	t.Run("Timestamp", func(t *testing.T) {
+		nullabletest.RunConformance(t, nullabletest.Spec[nullable.Range[nullable.Timestamp]]{
+			Valid: []nullable.Range[nullable.Timestamp]{
+				mustNewRange(nullable.NewTimestamp(timeutil.Timestamp{}, false), nullable.NewTimestampFromTime(genesis, true), false, false, true),
+			},
+		})
+	})
+}
+
+func TestNewRange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name                           string
+			lower, upper                   nullable.Int64
+			lowerInclusive, upperInclusive bool
+			want                           nullable.Range[nullable.Int64]
+		}{
+			{
+				"canonical",
+				nullable.NewInt64(1, true),
+				nullable.NewInt64(10, true),
+				true, false,
+				int64Range(1, 10),
+			},
+			{
+				"inclusive upper",
+				nullable.NewInt64(1, true),
+				nullable.NewInt64(10, true),
+				true, true,
+				int64Range(1, 11),
+			},
+			{
+				"exclusive lower",
+				nullable.NewInt64(1, true),
+				nullable.NewInt64(10, true),
+				false, false,
+				int64Range(2, 10),
+			},
+			{
+				"inclusive unbounded",
+				int64Null,
+				int64Null,
+				true, true,
+				int64Range(math.MinInt64, math.MaxInt64),
+			},
+			{
+				"single value",
+				nullable.NewInt64(1, true),
+				nullable.NewInt64(1, true),
+				true, true,
+				int64Range(1, 2),
+			},
+			{
+				"empty",
+				nullable.NewInt64(1, true),
+				nullable.NewInt64(1, true),
+				true, false,
+				nullable.NewEmptyRange[nullable.Int64](),
+			},
+			{
+				"empty after canonicalization",
+				nullable.NewInt64(1, true),
+				nullable.NewInt64(1, true),
+				false, true,
+				nullable.NewEmptyRange[nullable.Int64](),
+			},
+			{
+				"null",
+				nullable.NewInt64(10, true),
+				nullable.NewInt64(1, true),
+				true, false,
+				nullable.Range[nullable.Int64]{},
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n, err := nullable.NewRange(tc.lower, tc.upper, tc.lowerInclusive, tc.upperInclusive, tc.want.Valid)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+
+	t.Run("success: continuous", func(t *testing.T) {
+		n := mustNewRange(nullable.NewTime(genesis, true), nullable.NewTime(genesis, true), true, true, true)
+		require.False(t, n.Empty)
+		require.True(t, n.LowerInclusive)
+		require.True(t, n.UpperInclusive)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name         string
+			lower, upper nullable.Int64
+			want         string
+		}{
+			{
+				"inverted",
+				nullable.NewInt64(10, true),
+				nullable.NewInt64(1, true),
+				"range lower bound must be less than or equal to range upper bound",
+			},
+			{
+				"inverted by one",
+				nullable.NewInt64(1, true),
+				nullable.NewInt64(0, true),
+				"range lower bound must be less than or equal to range upper bound",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := nullable.NewRange(tc.lower, tc.upper, false, true, true)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+}
+
+func TestRange_Contains(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Range[nullable.Int64]
+			v    nullable.Int64
+			want nullable.Bool
+		}{
+			{
+				"null range",
+				nullable.Range[nullable.Int64]{},
+				nullable.NewInt64(1, true),
+				nullable.NewBool(false, false),
+			},
+			{
+				"null value",
+				int64Range(1, 10),
+				int64Null,
+				nullable.NewBool(false, false),
+			},
+			{
+				"empty",
+				nullable.NewEmptyRange[nullable.Int64](),
+				nullable.NewInt64(1, true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"lower",
+				int64Range(1, 10),
+				nullable.NewInt64(1, true),
+				nullable.NewBool(true, true),
+			},
+			{
+				"upper",
+				int64Range(1, 10),
+				nullable.NewInt64(10, true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"below",
+				int64Range(1, 10),
+				nullable.NewInt64(0, true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"unbounded",
+				int64Range(math.MinInt64, math.MaxInt64),
+				nullable.NewInt64(math.MaxInt64, true),
+				nullable.NewBool(true, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Contains(tc.v))
+			})
+		}
+	})
+
+	t.Run("success: continuous", func(t *testing.T) {
+		n := mustNewRange(nullable.NewTime(genesis, true), nullable.NewTime(genesis.Add(time.Hour), true), false, true, true)
+		require.Equal(t, nullable.NewBool(false, true), n.Contains(nullable.NewTime(genesis, true)))
+		require.Equal(t, nullable.NewBool(true, true), n.Contains(nullable.NewTime(genesis.Add(time.Nanosecond), true)))
+		require.Equal(t, nullable.NewBool(true, true), n.Contains(nullable.NewTime(genesis.Add(time.Hour), true)))
+	})
+}
+
+func TestRange_Overlaps(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			a    nullable.Range[nullable.Int64]
+			b    nullable.Range[nullable.Int64]
+			want nullable.Bool
+		}{
+			{
+				"null",
+				nullable.Range[nullable.Int64]{},
+				int64Range(1, 10),
+				nullable.NewBool(false, false),
+			},
+			{
+				"empty",
+				nullable.NewEmptyRange[nullable.Int64](),
+				int64Range(math.MinInt64, math.MaxInt64),
+				nullable.NewBool(false, true),
+			},
+			{
+				"adjacent",
+				int64Range(1, 10),
+				int64Range(10, 20),
+				nullable.NewBool(false, true),
+			},
+			{
+				"overlapping",
+				int64Range(1, 11),
+				int64Range(10, 20),
+				nullable.NewBool(true, true),
+			},
+			{
+				"unbounded",
+				int64Range(math.MinInt64, 1),
+				int64Range(0, math.MaxInt64),
+				nullable.NewBool(true, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.a.Overlaps(tc.b))
+				require.Equal(t, tc.want, tc.b.Overlaps(tc.a))
+			})
+		}
+	})
+}
+
+func TestRange_Intersect(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			a    nullable.Range[nullable.Int64]
+			b    nullable.Range[nullable.Int64]
+			want nullable.Range[nullable.Int64]
+		}{
+			{
+				"null",
+				nullable.Range[nullable.Int64]{},
+				int64Range(1, 10),
+				nullable.Range[nullable.Int64]{},
+			},
+			{
+				"empty",
+				nullable.NewEmptyRange[nullable.Int64](),
+				int64Range(1, 10),
+				nullable.NewEmptyRange[nullable.Int64](),
+			},
+			{
+				"disjoint",
+				int64Range(1, 10),
+				int64Range(10, 20),
+				nullable.NewEmptyRange[nullable.Int64](),
+			},
+			{
+				"overlapping",
+				int64Range(1, 15),
+				int64Range(10, 20),
+				int64Range(10, 15),
+			},
+			{
+				"nested",
+				int64Range(1, 20),
+				int64Range(10, 15),
+				int64Range(10, 15),
+			},
+			{
+				"unbounded",
+				int64Range(math.MinInt64, 15),
+				int64Range(10, math.MaxInt64),
+				int64Range(10, 15),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.a.Intersect(tc.b))
+				require.Equal(t, tc.want, tc.b.Intersect(tc.a))
+			})
+		}
+	})
+
+	t.Run("success: continuous", func(t *testing.T) {
+		a := mustNewRange(nullable.NewTime(genesis, true), nullable.NewTime(genesis.Add(time.Hour), true), true, true, true)
+		b := mustNewRange(nullable.NewTime(genesis.Add(time.Hour), true), nullable.NewTime(time.Time{}, false), false, false, true)
+		require.True(t, a.Intersect(b).Empty)
+
+		b.LowerInclusive = true
+		require.Equal(t, mustNewRange(nullable.NewTime(genesis.Add(time.Hour), true), nullable.NewTime(genesis.Add(time.Hour), true), true, true, true), a.Intersect(b))
+	})
+}
+
+func TestRange_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   driver.Valuer
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.Range[nullable.Int64]{},
+				nil,
+			},
+			{
+				"empty",
+				nullable.NewEmptyRange[nullable.Int64](),
+				"empty",
+			},
+			{
+				"Int64",
+				int64Range(1, 10),
+				"[1,10)",
+			},
+			{
+				"Int64: unbounded",
+				int64Range(math.MinInt64, math.MaxInt64),
+				"(,)",
+			},
+			{
+				"Uint256",
+				mustNewRange(nullable.NewUint256(bigutil.NewUint256FromUint64(0), true), nullable.NewUint256(bigutil.MustNewUint256(maxUint256), true), false, true, true),
+				"(0,115792089237316195423570985008687907853269984665640564039457584007913129639935]",
+			},
+			{
+				"Date",
+				mustNewRange(nullable.NewDate(2009, time.January, 3, true), nullable.NewDate(2009, time.January, 9, true), true, true, true),
+				"[2009-01-03,2009-01-10)",
+			},
+			{
+				"Date: max",
+				mustNewRange(nullable.NewDate(9999, time.December, 30, true), nullable.NewDate(9999, time.December, 31, true), false, true, true),
+				"[9999-12-31,9999-12-31]",
+			},
+			{
+				"Time",
+				mustNewRange(nullable.NewTime(genesisNano.In(jst), true), nullable.NewTime(time.Time{}, false), true, false, true),
+				"[2009-01-03T18:15:05.123456Z,)",
+			},
+			{
+				"Timestamp",
+				mustNewRange(nullable.NewTimestamp(timeutil.Timestamp{}, false), nullable.NewTimestampFromTime(genesis, true), false, false, true),
+				"(,2009-01-03T18:15:05Z)",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestRange_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"int64",
+				int64(0),
+				"unsupported source type: int64",
+			},
+			{
+				"string: empty",
+				"",
+				"not a range literal",
+			},
+			{
+				"string: no brackets",
+				"1,10",
+				"not a range literal",
+			},
+			{
+				"string: one bound",
+				"[1)",
+				"too few bounds",
+			},
+			{
+				"string: three bounds",
+				"[1,2,3)",
+				"too many bounds",
+			},
+			{
+				"string: unterminated quote",
+				`["1,10)`,
+				"unterminated quote",
+			},
+			{
+				"string: invalid bound",
+				"[a,10)",
+				"invalid source",
+			},
+			{
+				"string: quoted empty bound",
+				`["",10)`,
+				"invalid source",
+			},
+			{
+				"[]byte: inverted",
+				[]byte("[10,1)"),
+				"lower bound must be less than or equal to range upper bound",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Range[nullable.Int64]
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.Range[nullable.Int64]
+		}{
+			{
+				"nil",
+				nil,
+				nullable.Range[nullable.Int64]{},
+			},
+			{
+				"string: empty range",
+				"empty",
+				nullable.NewEmptyRange[nullable.Int64](),
+			},
+			{
+				"string: canonical",
+				"[1,10)",
+				int64Range(1, 10),
+			},
+			{
+				"string: non-canonical",
+				"(0,9]",
+				int64Range(1, 10),
+			},
+			{
+				"string: quoted and spaced",
+				`[ "1" , 1\0 )`,
+				int64Range(1, 10),
+			},
+			{
+				"string: equal exclusive bounds",
+				"[1,1)",
+				nullable.NewEmptyRange[nullable.Int64](),
+			},
+			{
+				"[]byte: unbounded",
+				[]byte("(,)"),
+				int64Range(math.MinInt64, math.MaxInt64),
+			},
+			{
+				"[]byte: inclusive unbounded",
+				[]byte("[,10]"),
+				int64Range(math.MinInt64, 11),
+			},
+			{
+				"[]byte: inclusive max",
+				[]byte("[1,9223372036854775807]"),
+				mustNewRange(nullable.NewInt64(1, true), nullable.NewInt64(math.MaxInt64, true), true, true, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Range[nullable.Int64]
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+
+	t.Run("success: PostgreSQL tstzrange", func(t *testing.T) {
+		var n nullable.Range[nullable.Time]
+		err := n.Scan([]byte(`["2009-01-04 03:15:05.123456+09","2009-01-03 18:15:05.5+00")`))
+		require.NoError(t, err)
+		require.True(t, n.Lower.Time.Equal(genesisNano.Truncate(time.Microsecond)))
+		require.True(t, n.Upper.Time.Equal(genesis.Add(500*time.Millisecond)))
+	})
+}
+
+func TestRange_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   json.Marshaler
+			want []byte
+		}{
+			{
+				"null",
+				nullable.Range[nullable.Int64]{},
+				[]byte(`null`),
+			},
+			{
+				"empty",
+				nullable.NewEmptyRange[nullable.Int64](),
+				[]byte(`{"empty":true}`),
+			},
+			{
+				"Int64",
+				int64Range(1, 10),
+				[]byte(`{"lower":1,"upper":10,"bounds":"[)"}`),
+			},
+			{
+				"Int64: unbounded",
+				int64Range(math.MinInt64, math.MaxInt64),
+				[]byte(`{"lower":null,"upper":null,"bounds":"()"}`),
+			},
+			{
+				"Timestamp",
+				mustNewRange(nullable.NewTimestampFromTime(genesis, true), nullable.NewTimestamp(timeutil.Timestamp{}, false), true, false, true),
+				[]byte(`{"lower":1231006505,"upper":null,"bounds":"[)"}`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestRange_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"string",
+				[]byte(`"[1,10)"`),
+				"",
+			},
+			{
+				"invalid bound",
+				[]byte(`{"lower":"1"}`),
+				"",
+			},
+			{
+				"invalid bounds",
+				[]byte(`{"bounds":"[["}`),
+				"invalid bounds",
+			},
+			{
+				"inverted",
+				[]byte(`{"lower":10,"upper":1}`),
+				"lower bound must be less than or equal to range upper bound",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Range[nullable.Int64]
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Range[nullable.Int64]
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.Range[nullable.Int64]{},
+			},
+			{
+				"empty",
+				[]byte(`{"empty":true}`),
+				nullable.NewEmptyRange[nullable.Int64](),
+			},
+			{
+				"default bounds",
+				[]byte(`{"lower":1,"upper":10}`),
+				int64Range(1, 10),
+			},
+			{
+				"non-canonical",
+				[]byte(`{"lower":0,"upper":9,"bounds":"(]"}`),
+				int64Range(1, 10),
+			},
+			{
+				"unbounded",
+				[]byte(`{}`),
+				int64Range(math.MinInt64, math.MaxInt64),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Range[nullable.Int64]
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestRange_Generate(t *testing.T) {
+	ns := generate[nullable.Range[nullable.Int64]](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Range[nullable.Int64]
+	}{
+		{
+			"null",
+			nullable.Range[nullable.Int64]{},
+		},
+		{
+			"empty",
+			nullable.NewEmptyRange[nullable.Int64](),
+		},
+		{
+			"unbounded",
+			int64Range(math.MinInt64, math.MaxInt64),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzRange_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Range[nullable.Int64]](f,
+		[]byte(""),
+		[]byte("1,10"),
+		[]byte("[1)"),
+		[]byte("[1,2,3)"),
+		[]byte(`["1,10)`),
+		[]byte("[10,1)"),
+		[]byte("empty"),
+		[]byte("[1,10)"),
+		[]byte("(0,9]"),
+		[]byte(`[ "1" , 1\0 )`),
+		[]byte("(,)"),
+	)
+}
+
+func FuzzRange_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Range[nullable.Int64]](f,
+		[]byte(`"[1,10)"`),
+		[]byte(`{"lower":"1"}`),
+		[]byte(`{"bounds":"[["}`),
+		[]byte(`{"lower":10,"upper":1}`),
+		[]byte(`null`),
+		[]byte(`{"empty":true}`),
+		[]byte(`{"lower":1,"upper":10}`),
+		[]byte(`{"lower":0,"upper":9,"bounds":"(]"}`),
+		[]byte(`{}`),
+	)
+}
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"math/rand"
+	"reflect"
+	"unicode/utf8"
 
 	"go.yaml.in/yaml/v3"
 )
@@ -99,3 +102,26 @@ func (n *String) UnmarshalYAML(value *yaml.Node) error {
 
 	return nil
 }
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (the empty string, "null", a NUL character or multibyte text),
+// or a random valid UTF-8 string of at most size runes.
+func (String) Generate(r *rand.Rand, size int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewString("", false))
+	}
+
+	if s, ok := generateEdge(r, "", "null", "\x00", "ぬるぽ"); ok {
+		return reflect.ValueOf(NewString(s, true))
+	}
+
+	rs := make([]rune, r.Intn(size+1))
+	for i := range rs {
+		rs[i] = rune(r.Intn(utf8.MaxRune + 1))
+		if !utf8.ValidRune(rs[i]) {
+			rs[i] = utf8.RuneError
+		}
+	}
+
+	return reflect.ValueOf(NewString(string(rs), true))
+}
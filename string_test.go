@@ -10,6 +10,7 @@ import (
 	"go.yaml.in/yaml/v3"
 
 	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
 )
 
 func TestString(t *testing.T) {
@@ -22,6 +23,20 @@ func TestString(t *testing.T) {
 	require.Implements(t, (*yaml.Unmarshaler)(nil), &n)
 }
 
+func TestString_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.String]{
+		Valid: []nullable.String{
+			nullable.NewString("", true),
+			nullable.NewString("null", true),
+			nullable.NewString("m0t0k1ch1", true),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`0`),
+		},
+	})
+}
+
 func TestNewStringFromStringPtr(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -319,3 +334,67 @@ func TestString_UnmarshalYAML(t *testing.T) {
 		}
 	})
 }
+
+func TestString_Generate(t *testing.T) {
+	ns := generate[nullable.String](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.String
+	}{
+		{
+			"null",
+			nullable.NewString("", false),
+		},
+		{
+			"empty",
+			nullable.NewString("", true),
+		},
+		{
+			`"null"`,
+			nullable.NewString("null", true),
+		},
+		{
+			"NUL",
+			nullable.NewString("\x00", true),
+		},
+		{
+			"multibyte",
+			nullable.NewString("ぬるぽ", true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzString_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.String](f,
+		[]byte(""),
+		[]byte("non-empty"),
+	)
+}
+
+func FuzzString_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.String](f,
+		[]byte(`true`),
+		[]byte(`0`),
+		[]byte(`null`),
+		[]byte(`""`),
+		[]byte(`"non-empty"`),
+	)
+}
+
+func FuzzString_UnmarshalYAML(f *testing.F) {
+	nullabletest.FuzzYAML[nullable.String](f,
+		[]byte(`null`),
+		[]byte(`~`),
+		[]byte(`[]`),
+		[]byte(`{}`),
+		[]byte(`""`),
+		[]byte(`non-empty`),
+	)
+}
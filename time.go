@@ -0,0 +1,240 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"time"
+)
+
+// defaultTimePrecision is the precision of PostgreSQL timestamptz and MySQL DATETIME(6).
+const defaultTimePrecision = time.Microsecond
+
+// mysqlDateTimeLayout is the layout of MySQL DATETIME values, with optional fractional seconds.
+const mysqlDateTimeLayout = "2006-01-02 15:04:05.999999999"
+
+// Time represents a nullable time.Time wrapping sql.NullTime.
+// Value truncates it to its precision, which defaults to time.Microsecond
+// and can be changed per value with WithPrecision.
+type Time struct {
+	sql.NullTime
+
+	precision time.Duration
+}
+
+// NewTime returns a new Time.
+func NewTime(t time.Time, valid bool) Time {
+	return Time{
+		NullTime: sql.NullTime{
+			Time:  t,
+			Valid: valid,
+		},
+	}
+}
+
+// NewTimeFromTimePtr returns a new Time from a *time.Time.
+// It captures the value at call time; a nil pointer is treated as invalid.
+func NewTimeFromTimePtr(t *time.Time) Time {
+	if t == nil {
+		return NewTime(time.Time{}, false)
+	}
+
+	return NewTime(*t, true)
+}
+
+// WithPrecision returns a copy of the value whose Value truncates it to a multiple of d,
+// such as time.Second, time.Millisecond or time.Nanosecond, to match the precision of its column.
+// A d of zero or less restores the default, time.Microsecond.
+func (n Time) WithPrecision(d time.Duration) Time {
+	n.precision = max(d, 0)
+
+	return n
+}
+
+// Precision returns the precision to which Value truncates the value.
+func (n Time) Precision() time.Duration {
+	if n.precision == 0 {
+		return defaultTimePrecision
+	}
+
+	return n.precision
+}
+
+// TimePtr returns the value as a *time.Time, or nil if invalid.
+// The pointer refers to a copy.
+func (n Time) TimePtr() *time.Time {
+	if !n.Valid {
+		return nil
+	}
+
+	return &n.Time
+}
+
+// Add returns the time d after the value, or null if invalid.
+// The result keeps the precision of the value.
+func (n Time) Add(d time.Duration) Time {
+	if !n.Valid {
+		return Time{precision: n.precision}
+	}
+
+	n.Time = n.Time.Add(d)
+
+	return n
+}
+
+// Sub returns the duration from u to the value, or null if either is invalid.
+// It saturates as time.Time.Sub does.
+func (n Time) Sub(u Time) Duration {
+	if !n.Valid || !u.Valid {
+		return NewDuration(0, false)
+	}
+
+	return NewDuration(n.Time.Sub(u.Time), true)
+}
+
+// Before reports whether the value is before u, or returns null if either is invalid.
+func (n Time) Before(u Time) Bool {
+	if !n.Valid || !u.Valid {
+		return NewBool(false, false)
+	}
+
+	return NewBool(n.Time.Before(u.Time), true)
+}
+
+// After reports whether the value is after u, or returns null if either is invalid.
+func (n Time) After(u Time) Bool {
+	if !n.Valid || !u.Valid {
+		return NewBool(false, false)
+	}
+
+	return NewBool(n.Time.After(u.Time), true)
+}
+
+// Between reports whether the value is within [lo, hi], as SQL BETWEEN does.
+// It returns null if the result depends on an invalid operand,
+// e.g. false if the value is valid and after a valid hi, even if lo is invalid.
+func (n Time) Between(lo Time, hi Time) Bool {
+	return lo.After(n).Not().And(hi.Before(n).Not())
+}
+
+// Truncate returns the value rounded down to a multiple of d since the zero time,
+// as time.Time.Truncate does, or null if invalid.
+// The result keeps the precision of the value.
+func (n Time) Truncate(d time.Duration) Time {
+	if !n.Valid {
+		return Time{precision: n.precision}
+	}
+
+	n.Time = n.Time.Truncate(d)
+
+	return n
+}
+
+// Value implements driver.Valuer.
+// It returns the value in UTC truncated to its precision, or nil if invalid.
+func (n Time) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Time.UTC().Truncate(n.Precision()), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts:
+//   - time.Time
+//   - string (RFC 3339 or MySQL DATETIME)
+//   - []byte (RFC 3339 or MySQL DATETIME)
+//   - nil
+//
+// MySQL DATETIME values carry no time zone and are interpreted as UTC.
+func (n *Time) Scan(src any) error {
+	if src == nil {
+		n.Time, n.Valid = time.Time{}, false
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case time.Time:
+		n.Time, n.Valid = v, true
+
+		return nil
+
+	case string:
+		return n.parse(v)
+
+	case []byte:
+		return n.parse(string(v))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+func (n *Time) parse(s string) error {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		if t, err = time.ParseInLocation(mysqlDateTimeLayout, s, time.UTC); err != nil {
+			return fmt.Errorf("invalid source: %q is neither RFC 3339 nor MySQL DATETIME", s)
+		}
+	}
+
+	n.Time, n.Valid = t, true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in RFC 3339 format with its own time zone offset,
+// or null if invalid.
+func (n Time) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.Time)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string in RFC 3339 format or null.
+func (n *Time) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.Time, n.Valid = time.Time{}, false
+
+		return nil
+	}
+
+	if err := json.Unmarshal(b, &n.Time); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (the zero time, the Unix epoch, 9999-12-31T23:59:59.999999999Z
+// or a time with nanoseconds in a non-UTC zone), or a random UTC time between the epoch and 9999-12-31.
+func (Time) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewTime(time.Time{}, false))
+	}
+
+	if t, ok := generateEdge(r,
+		time.Time{},
+		time.Unix(0, 0).UTC(),
+		time.Unix(maxTimestampUnix, 999999999).UTC(),
+		time.Date(2009, 1, 3, 18, 15, 5, 123456789, time.FixedZone("", 9*60*60)),
+	); ok {
+		return reflect.ValueOf(NewTime(t, true))
+	}
+
+	return reflect.ValueOf(NewTime(time.Unix(r.Int63n(maxTimestampUnix+1), r.Int63n(int64(time.Second))).UTC(), true))
+}
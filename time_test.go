@@ -0,0 +1,836 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+var (
+	jst = time.FixedZone("JST", 9*60*60)
+
+	genesis     = time.Date(2009, 1, 3, 18, 15, 5, 0, time.UTC)
+	genesisNano = time.Date(2009, 1, 3, 18, 15, 5, 123456789, time.UTC)
+)
+
+func TestTime(t *testing.T) {
+	var n nullable.Time
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestTime_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Time]{
+		Valid: []nullable.Time{
+			nullable.NewTime(time.Time{}, true),
+			nullable.NewTime(genesis, true),
+			nullable.NewTime(genesis.In(jst), true),
+			nullable.NewTime(genesisNano.Truncate(time.Microsecond), true),
+		},
+		InvalidScan: []any{
+			int64(0),
+			"",
+			[]byte("2009-01-03"),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`0`),
+			[]byte(`""`),
+			[]byte(`"2009-01-03 18:15:05"`),
+		},
+		Equal: func(a, b nullable.Time) bool {
+			return a.Valid == b.Valid && a.Time.Equal(b.Time)
+		},
+	})
+}
+
+func TestNewTimeFromTimePtr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   *time.Time
+			want nullable.Time
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewTime(time.Time{}, false),
+			},
+			{
+				"zero",
+				new(time.Time{}),
+				nullable.NewTime(time.Time{}, true),
+			},
+			{
+				"genesis",
+				new(genesis),
+				nullable.NewTime(genesis, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n := nullable.NewTimeFromTimePtr(tc.in)
+				require.Equal(t, tc.want.Valid, n.Valid)
+				require.Equal(t, tc.want.Time, n.Time)
+			})
+		}
+	})
+
+	t.Run("success: captures value at call time", func(t *testing.T) {
+		tm := new(genesis)
+		n := nullable.NewTimeFromTimePtr(tm)
+
+		*tm = time.Time{}
+
+		require.True(t, n.Valid)
+		require.Equal(t, genesis, n.Time)
+	})
+}
+
+func TestTime_TimePtr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Time
+			want *time.Time
+		}{
+			{
+				"null",
+				nullable.NewTime(time.Time{}, false),
+				nil,
+			},
+			{
+				"zero",
+				nullable.NewTime(time.Time{}, true),
+				new(time.Time{}),
+			},
+			{
+				"genesis",
+				nullable.NewTime(genesis, true),
+				new(genesis),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				tm := tc.in.TimePtr()
+				require.Equal(t, tc.want, tm)
+			})
+		}
+	})
+
+	t.Run("success: pointer refers to a copy", func(t *testing.T) {
+		n := nullable.NewTime(genesis, true)
+		tm := n.TimePtr()
+
+		*tm = time.Time{}
+
+		require.True(t, n.Valid)
+		require.Equal(t, genesis, n.Time)
+	})
+}
+
+func TestTime_Precision(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Time
+			want time.Duration
+		}{
+			{
+				"default",
+				nullable.NewTime(genesis, true),
+				time.Microsecond,
+			},
+			{
+				"ms",
+				nullable.NewTime(genesis, true).WithPrecision(time.Millisecond),
+				time.Millisecond,
+			},
+			{
+				"ms: added",
+				nullable.NewTime(genesis, true).WithPrecision(time.Millisecond).Add(time.Hour),
+				time.Millisecond,
+			},
+			{
+				"negative",
+				nullable.NewTime(genesis, true).WithPrecision(-time.Second),
+				time.Microsecond,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				p := tc.in.Precision()
+				require.Equal(t, tc.want, p)
+			})
+		}
+	})
+
+	t.Run("scan", func(t *testing.T) {
+		n := nullable.NewTime(time.Time{}, false).WithPrecision(time.Second)
+		require.NoError(t, n.Scan(genesisNano))
+		require.Equal(t, time.Second, n.Precision())
+
+		v, err := n.Value()
+		require.NoError(t, err)
+		require.Equal(t, genesis, v)
+	})
+}
+
+func TestTime_Add(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Time
+			d    time.Duration
+			want nullable.Time
+		}{
+			{
+				"null",
+				nullable.NewTime(time.Time{}, false),
+				time.Hour,
+				nullable.NewTime(time.Time{}, false),
+			},
+			{
+				"null with a stale time",
+				nullable.NewTime(genesis, false).WithPrecision(time.Second),
+				time.Hour,
+				nullable.NewTime(time.Time{}, false).WithPrecision(time.Second),
+			},
+			{
+				"positive",
+				nullable.NewTime(genesis, true),
+				time.Hour,
+				nullable.NewTime(genesis.Add(time.Hour), true),
+			},
+			{
+				"negative",
+				nullable.NewTime(genesis, true),
+				-24 * time.Hour,
+				nullable.NewTime(genesis.AddDate(0, 0, -1), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Add(tc.d))
+			})
+		}
+	})
+}
+
+func TestTime_Sub(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			a    nullable.Time
+			b    nullable.Time
+			want nullable.Duration
+		}{
+			{
+				"null - valid",
+				nullable.NewTime(time.Time{}, false),
+				nullable.NewTime(genesis, true),
+				nullable.NewDuration(0, false),
+			},
+			{
+				"valid - null",
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(time.Time{}, false),
+				nullable.NewDuration(0, false),
+			},
+			{
+				"later - earlier",
+				nullable.NewTime(genesis.Add(90*time.Minute), true),
+				nullable.NewTime(genesis, true),
+				nullable.NewDuration(90*time.Minute, true),
+			},
+			{
+				"earlier - later",
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(genesis.Add(90*time.Minute), true),
+				nullable.NewDuration(-90*time.Minute, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.a.Sub(tc.b))
+			})
+		}
+	})
+}
+
+func TestTime_Before(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			a    nullable.Time
+			b    nullable.Time
+			want nullable.Bool
+		}{
+			{
+				"null",
+				nullable.NewTime(time.Time{}, false),
+				nullable.NewTime(genesis, true),
+				nullable.NewBool(false, false),
+			},
+			{
+				"before",
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(true, true),
+			},
+			{
+				"equal",
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(genesis, true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"after",
+				nullable.NewTime(genesis.Add(time.Hour), true),
+				nullable.NewTime(genesis, true),
+				nullable.NewBool(false, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.a.Before(tc.b))
+			})
+		}
+	})
+}
+
+func TestTime_After(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			a    nullable.Time
+			b    nullable.Time
+			want nullable.Bool
+		}{
+			{
+				"null",
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(time.Time{}, false),
+				nullable.NewBool(false, false),
+			},
+			{
+				"before",
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"equal",
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(genesis, true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"after",
+				nullable.NewTime(genesis.Add(time.Hour), true),
+				nullable.NewTime(genesis, true),
+				nullable.NewBool(true, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.a.After(tc.b))
+			})
+		}
+	})
+}
+
+func TestTime_Between(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Time
+			lo   nullable.Time
+			hi   nullable.Time
+			want nullable.Bool
+		}{
+			{
+				"null",
+				nullable.NewTime(time.Time{}, false),
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(false, false),
+			},
+			{
+				"lo",
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(true, true),
+			},
+			{
+				"hi",
+				nullable.NewTime(genesis.Add(time.Hour), true),
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(true, true),
+			},
+			{
+				"before lo",
+				nullable.NewTime(genesis.Add(-time.Hour), true),
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"after hi",
+				nullable.NewTime(genesis.Add(2*time.Hour), true),
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"null lo",
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(time.Time{}, false),
+				nullable.NewTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(false, false),
+			},
+			{
+				"null lo, after hi",
+				nullable.NewTime(genesis.Add(2*time.Hour), true),
+				nullable.NewTime(time.Time{}, false),
+				nullable.NewTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"null hi, before lo",
+				nullable.NewTime(genesis.Add(-time.Hour), true),
+				nullable.NewTime(genesis, true),
+				nullable.NewTime(time.Time{}, false),
+				nullable.NewBool(false, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Between(tc.lo, tc.hi))
+			})
+		}
+	})
+}
+
+func TestTime_Truncate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Time
+			d    time.Duration
+			want nullable.Time
+		}{
+			{
+				"null",
+				nullable.NewTime(time.Time{}, false),
+				time.Hour,
+				nullable.NewTime(time.Time{}, false),
+			},
+			{
+				"null with a stale time",
+				nullable.NewTime(genesis, false).WithPrecision(time.Second),
+				time.Hour,
+				nullable.NewTime(time.Time{}, false).WithPrecision(time.Second),
+			},
+			{
+				"hour",
+				nullable.NewTime(genesis, true),
+				time.Hour,
+				nullable.NewTime(time.Date(2009, 1, 3, 18, 0, 0, 0, time.UTC), true),
+			},
+			{
+				"day",
+				nullable.NewTime(genesis, true),
+				24 * time.Hour,
+				nullable.NewTime(time.Date(2009, 1, 3, 0, 0, 0, 0, time.UTC), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Truncate(tc.d))
+			})
+		}
+	})
+}
+
+func TestTime_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Time
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewTime(time.Time{}, false),
+				nil,
+			},
+			{
+				"zero",
+				nullable.NewTime(time.Time{}, true),
+				time.Time{},
+			},
+			{
+				"UTC",
+				nullable.NewTime(genesis, true),
+				genesis,
+			},
+			{
+				"JST: normalized to UTC",
+				nullable.NewTime(genesis.In(jst), true),
+				genesis,
+			},
+			{
+				"nanoseconds: truncated to microseconds",
+				nullable.NewTime(genesisNano, true),
+				time.Date(2009, 1, 3, 18, 15, 5, 123456000, time.UTC),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+
+	t.Run("success: precision", func(t *testing.T) {
+		tcs := []struct {
+			name      string
+			precision time.Duration
+			want      driver.Value
+		}{
+			{
+				"default",
+				0,
+				time.Date(2009, 1, 3, 18, 15, 5, 123456000, time.UTC),
+			},
+			{
+				"s",
+				time.Second,
+				genesis,
+			},
+			{
+				"ms",
+				time.Millisecond,
+				time.Date(2009, 1, 3, 18, 15, 5, 123000000, time.UTC),
+			},
+			{
+				"µs",
+				time.Microsecond,
+				time.Date(2009, 1, 3, 18, 15, 5, 123456000, time.UTC),
+			},
+			{
+				"ns",
+				time.Nanosecond,
+				genesisNano,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := nullable.NewTime(genesisNano.In(jst), true).WithPrecision(tc.precision).Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestTime_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"int64",
+				int64(1231006505),
+				"unsupported source type: int64",
+			},
+			{
+				"string: empty",
+				"",
+				"invalid source",
+			},
+			{
+				"string: date",
+				"2009-01-03",
+				"invalid source",
+			},
+			{
+				"[]byte: MySQL zero DATETIME",
+				[]byte("0000-00-00 00:00:00"),
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Time
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.Time
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewTime(time.Time{}, false),
+			},
+			{
+				"time.Time",
+				genesis.In(jst),
+				nullable.NewTime(genesis.In(jst), true),
+			},
+			{
+				"string: RFC 3339",
+				"2009-01-04T03:15:05+09:00",
+				nullable.NewTime(genesis.In(time.FixedZone("", 9*60*60)), true),
+			},
+			{
+				"string: RFC 3339 with nanoseconds",
+				"2009-01-03T18:15:05.123456789Z",
+				nullable.NewTime(genesisNano, true),
+			},
+			{
+				"[]byte: MySQL DATETIME",
+				[]byte("2009-01-03 18:15:05"),
+				nullable.NewTime(genesis, true),
+			},
+			{
+				"[]byte: MySQL DATETIME(6)",
+				[]byte("2009-01-03 18:15:05.123456"),
+				nullable.NewTime(genesisNano.Truncate(time.Microsecond), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Time
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.Valid, n.Valid)
+				require.Equal(t, tc.want.Time, n.Time)
+			})
+		}
+	})
+}
+
+func TestTime_MarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Time
+			want string
+		}{
+			{
+				"year out of range",
+				nullable.NewTime(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC), true),
+				"year outside of range",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.MarshalJSON()
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Time
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewTime(time.Time{}, false),
+				[]byte(`null`),
+			},
+			{
+				"zero",
+				nullable.NewTime(time.Time{}, true),
+				[]byte(`"0001-01-01T00:00:00Z"`),
+			},
+			{
+				"UTC",
+				nullable.NewTime(genesisNano, true),
+				[]byte(`"2009-01-03T18:15:05.123456789Z"`),
+			},
+			{
+				"JST",
+				nullable.NewTime(genesis.In(jst), true),
+				[]byte(`"2009-01-04T03:15:05+09:00"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := tc.in.MarshalJSON()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestTime_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"empty",
+				[]byte{},
+				"",
+			},
+			{
+				"number",
+				[]byte(`1231006505`),
+				"",
+			},
+			{
+				"string: empty",
+				[]byte(`""`),
+				"",
+			},
+			{
+				"string: MySQL DATETIME",
+				[]byte(`"2009-01-03 18:15:05"`),
+				"",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Time
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.Time
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewTime(time.Time{}, false),
+			},
+			{
+				"string: UTC",
+				[]byte(`"2009-01-03T18:15:05.123456789Z"`),
+				nullable.NewTime(genesisNano, true),
+			},
+			{
+				"string: JST",
+				[]byte(`"2009-01-04T03:15:05+09:00"`),
+				nullable.NewTime(genesis.In(time.FixedZone("", 9*60*60)), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.Time
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.Valid, n.Valid)
+				require.Equal(t, tc.want.Time, n.Time)
+			})
+		}
+	})
+}
+
+func TestTime_Generate(t *testing.T) {
+	ns := generate[nullable.Time](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Time
+	}{
+		{
+			"null",
+			nullable.NewTime(time.Time{}, false),
+		},
+		{
+			"zero",
+			nullable.NewTime(time.Time{}, true),
+		},
+		{
+			"epoch",
+			nullable.NewTime(time.Unix(0, 0).UTC(), true),
+		},
+		{
+			"max",
+			nullable.NewTime(time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC), true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzTime_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Time](f,
+		[]byte(""),
+		[]byte("2009-01-03"),
+		[]byte("0000-00-00 00:00:00"),
+		[]byte("2009-01-03 18:15:05"),
+		[]byte("2009-01-03 18:15:05.123456"),
+		[]byte("2009-01-04T03:15:05+09:00"),
+		[]byte("2009-01-03T18:15:05.123456789Z"),
+	)
+}
+
+func FuzzTime_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Time](f,
+		[]byte{},
+		[]byte(`1231006505`),
+		[]byte(`""`),
+		[]byte(`"2009-01-03 18:15:05"`),
+		[]byte(`null`),
+		[]byte(`"2009-01-03T18:15:05.123456789Z"`),
+		[]byte(`"2009-01-04T03:15:05+09:00"`),
+	)
+}
@@ -0,0 +1,269 @@
+package nullable
+
+import (
+	"bytes"
+	"cmp"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// timeOfDayLayout is the layout of times of day in SQL and JSON, with microsecond precision.
+const timeOfDayLayout = "15:04:05.999999"
+
+// TimeOfDay represents a nullable time of day, without a date or time zone.
+// As in PostgreSQL, it ranges from 00:00:00 to 24:00:00, the end of the day,
+// which is represented by Hour 24 and zero Minute, Second and Nanosecond.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+	Valid      bool
+}
+
+// NewTimeOfDay returns a new TimeOfDay.
+func NewTimeOfDay(hour int, minute int, second int, nanosecond int, valid bool) TimeOfDay {
+	return TimeOfDay{
+		Hour:       hour,
+		Minute:     minute,
+		Second:     second,
+		Nanosecond: nanosecond,
+		Valid:      valid,
+	}
+}
+
+// NewTimeOfDayFromTime returns a new TimeOfDay from the clock of t in its location.
+func NewTimeOfDayFromTime(t time.Time, valid bool) TimeOfDay {
+	hour, minute, second := t.Clock()
+
+	return NewTimeOfDay(hour, minute, second, t.Nanosecond(), valid)
+}
+
+// NullableString returns the value as a String in the form 15:04:05.999999.
+func (n TimeOfDay) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(n.format(), true)
+}
+
+// On returns the time of day on the date d in loc, or the zero time.Time if either is invalid.
+func (n TimeOfDay) On(d Date, loc *time.Location) time.Time {
+	if !n.Valid || !d.Valid {
+		return time.Time{}
+	}
+
+	return time.Date(d.Year, d.Month, d.Day, n.Hour, n.Minute, n.Second, n.Nanosecond, loc)
+}
+
+// Add returns the time of day d after the value, wrapping around midnight, or null if invalid.
+// d may be negative.
+func (n TimeOfDay) Add(d time.Duration) TimeOfDay {
+	if !n.Valid {
+		return TimeOfDay{}
+	}
+
+	since := (n.sinceMidnight() + d%(24*time.Hour) + 24*time.Hour) % (24 * time.Hour)
+
+	return NewTimeOfDayFromTime(time.Time{}.Add(since), true)
+}
+
+// Sub returns the duration from u to the value within the same day, which is negative
+// if u is later, or null if either is invalid.
+func (n TimeOfDay) Sub(u TimeOfDay) Duration {
+	if !n.Valid || !u.Valid {
+		return NewDuration(0, false)
+	}
+
+	return NewDuration(n.sinceMidnight()-u.sinceMidnight(), true)
+}
+
+// Compare compares the value with u, returning -1, 0 or +1.
+// Null sorts before all valid times of day.
+func (n TimeOfDay) Compare(u TimeOfDay) int {
+	if !n.Valid || !u.Valid {
+		return compareValid(n.Valid, u.Valid)
+	}
+
+	return cmp.Compare(n.sinceMidnight(), u.sinceMidnight())
+}
+
+// Before reports whether the value is before u, or returns null if either is invalid.
+func (n TimeOfDay) Before(u TimeOfDay) Bool {
+	if !n.Valid || !u.Valid {
+		return NewBool(false, false)
+	}
+
+	return NewBool(n.Compare(u) < 0, true)
+}
+
+// After reports whether the value is after u, or returns null if either is invalid.
+func (n TimeOfDay) After(u TimeOfDay) Bool {
+	if !n.Valid || !u.Valid {
+		return NewBool(false, false)
+	}
+
+	return NewBool(n.Compare(u) > 0, true)
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a string in the form 15:04:05.999999, or nil if invalid.
+// It returns an error if a field is out of range.
+func (n TimeOfDay) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	if err := n.check(); err != nil {
+		return nil, err
+	}
+
+	return n.format(), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts:
+//   - time.Time (its clock in its location)
+//   - string (15:04:05 with optional fractional seconds, or 24:00:00)
+//   - []byte (15:04:05 with optional fractional seconds, or 24:00:00)
+//   - nil
+func (n *TimeOfDay) Scan(src any) error {
+	if src == nil {
+		*n = TimeOfDay{}
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case time.Time:
+		*n = NewTimeOfDayFromTime(v, true)
+
+		return nil
+
+	case string:
+		return n.parse(v)
+
+	case []byte:
+		return n.parse(string(v))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in the form 15:04:05.999999, or null if invalid.
+// It returns an error if a field is out of range.
+func (n TimeOfDay) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	if err := n.check(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(n.format())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string in the form 15:04:05 with optional fractional seconds, 24:00:00, or null.
+func (n *TimeOfDay) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = TimeOfDay{}
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	return n.parse(s)
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (midnight, noon, 23:59:59.999999 or 24:00:00),
+// or a random time of day with microsecond precision.
+func (TimeOfDay) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(TimeOfDay{})
+	}
+
+	if t, ok := generateEdge(r,
+		NewTimeOfDay(0, 0, 0, 0, true),
+		NewTimeOfDay(12, 0, 0, 0, true),
+		NewTimeOfDay(23, 59, 59, 999999000, true),
+		NewTimeOfDay(24, 0, 0, 0, true),
+	); ok {
+		return reflect.ValueOf(t)
+	}
+
+	return reflect.ValueOf(NewTimeOfDay(0, 0, 0, 0, true).Add(time.Duration(r.Int63n(int64(24*time.Hour/time.Microsecond))) * time.Microsecond))
+}
+
+func (n TimeOfDay) sinceMidnight() time.Duration {
+	return time.Duration(n.Hour)*time.Hour +
+		time.Duration(n.Minute)*time.Minute +
+		time.Duration(n.Second)*time.Second +
+		time.Duration(n.Nanosecond)
+}
+
+func (n TimeOfDay) isEndOfDay() bool {
+	return n.Hour == 24 && n.Minute == 0 && n.Second == 0 && n.Nanosecond == 0
+}
+
+func (n TimeOfDay) check() error {
+	if n.isEndOfDay() {
+		return nil
+	}
+
+	if n.Hour < 0 || n.Hour > 23 || n.Minute < 0 || n.Minute > 59 ||
+		n.Second < 0 || n.Second > 59 || n.Nanosecond < 0 || n.Nanosecond > 999999999 {
+		return fmt.Errorf("invalid time of day: %d hours, %d minutes, %d seconds and %d nanoseconds", n.Hour, n.Minute, n.Second, n.Nanosecond)
+	}
+
+	return nil
+}
+
+func (n TimeOfDay) format() string {
+	if n.isEndOfDay() {
+		return "24:00:00"
+	}
+
+	return time.Time{}.Add(n.sinceMidnight()).Format(timeOfDayLayout)
+}
+
+func (n *TimeOfDay) parse(s string) error {
+	// time.Parse rejects hour 24, so 24:00:00 is parsed as 00:00:00 and checked to have no remainder.
+	rest, isEndOfDay := strings.CutPrefix(s, "24:")
+	if isEndOfDay {
+		s = "00:" + rest
+	}
+
+	t, err := time.Parse("15:04:05.999999999", s)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	v := NewTimeOfDayFromTime(t, true)
+	if isEndOfDay {
+		if v != NewTimeOfDay(0, 0, 0, 0, true) {
+			return fmt.Errorf("invalid source: %q is after 24:00:00", "24:"+rest)
+		}
+
+		v.Hour = 24
+	}
+
+	*n = v
+
+	return nil
+}
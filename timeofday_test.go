@@ -0,0 +1,644 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestTimeOfDay(t *testing.T) {
+	var n nullable.TimeOfDay
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestTimeOfDay_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.TimeOfDay]{
+		Valid: []nullable.TimeOfDay{
+			nullable.NewTimeOfDay(0, 0, 0, 0, true),
+			nullable.NewTimeOfDay(18, 15, 5, 0, true),
+			nullable.NewTimeOfDay(23, 59, 59, 999999000, true),
+			nullable.NewTimeOfDay(24, 0, 0, 0, true),
+		},
+		InvalidScan: []any{
+			int64(0),
+			"24:00:01",
+			[]byte("18:15"),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`0`),
+			[]byte(`""`),
+			[]byte(`"6:15:05 PM"`),
+		},
+	})
+}
+
+func TestNewTimeOfDayFromTime(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   time.Time
+			want nullable.TimeOfDay
+		}{
+			{
+				"UTC",
+				genesisNano,
+				nullable.NewTimeOfDay(18, 15, 5, 123456789, true),
+			},
+			{
+				"JST: clock in its location",
+				genesis.In(jst),
+				nullable.NewTimeOfDay(3, 15, 5, 0, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n := nullable.NewTimeOfDayFromTime(tc.in, true)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestTimeOfDay_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimeOfDay
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewTimeOfDay(0, 0, 0, 0, false),
+				nullable.NewString("", false),
+			},
+			{
+				"seconds",
+				nullable.NewTimeOfDay(18, 15, 5, 0, true),
+				nullable.NewString("18:15:05", true),
+			},
+			{
+				"nanoseconds: truncated to microseconds",
+				nullable.NewTimeOfDay(18, 15, 5, 123456789, true),
+				nullable.NewString("18:15:05.123456", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n := tc.in.NullableString()
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestTimeOfDay_On(t *testing.T) {
+	d := nullable.NewDate(2009, time.January, 3, true)
+
+	require.Equal(t, time.Time{}, nullable.NewTimeOfDay(18, 15, 5, 0, false).On(d, time.UTC))
+	require.Equal(t, time.Time{}, nullable.NewTimeOfDay(18, 15, 5, 0, true).On(nullable.Date{}, time.UTC))
+	require.Equal(t, genesisNano, nullable.NewTimeOfDay(18, 15, 5, 123456789, true).On(d, time.UTC))
+}
+
+func TestTimeOfDay_Add(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimeOfDay
+			d    time.Duration
+			want nullable.TimeOfDay
+		}{
+			{
+				"null",
+				nullable.NewTimeOfDay(0, 0, 0, 0, false),
+				time.Hour,
+				nullable.NewTimeOfDay(0, 0, 0, 0, false),
+			},
+			{
+				"null with a stale time of day",
+				nullable.NewTimeOfDay(9, 0, 0, 0, false),
+				time.Hour,
+				nullable.NewTimeOfDay(0, 0, 0, 0, false),
+			},
+			{
+				"forward",
+				nullable.NewTimeOfDay(9, 0, 0, 0, true),
+				90 * time.Minute,
+				nullable.NewTimeOfDay(10, 30, 0, 0, true),
+			},
+			{
+				"backward",
+				nullable.NewTimeOfDay(9, 0, 0, 0, true),
+				-time.Nanosecond,
+				nullable.NewTimeOfDay(8, 59, 59, 999999999, true),
+			},
+			{
+				"wraps past midnight",
+				nullable.NewTimeOfDay(23, 0, 0, 0, true),
+				2 * time.Hour,
+				nullable.NewTimeOfDay(1, 0, 0, 0, true),
+			},
+			{
+				"wraps before midnight",
+				nullable.NewTimeOfDay(1, 0, 0, 0, true),
+				-50 * time.Hour,
+				nullable.NewTimeOfDay(23, 0, 0, 0, true),
+			},
+			{
+				"from the end of the day",
+				nullable.NewTimeOfDay(24, 0, 0, 0, true),
+				-time.Hour,
+				nullable.NewTimeOfDay(23, 0, 0, 0, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n := tc.in.Add(tc.d)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestTimeOfDay_Sub(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimeOfDay
+			u    nullable.TimeOfDay
+			want nullable.Duration
+		}{
+			{
+				"null",
+				nullable.NewTimeOfDay(0, 0, 0, 0, false),
+				nullable.NewTimeOfDay(9, 0, 0, 0, true),
+				nullable.NewDuration(0, false),
+			},
+			{
+				"later",
+				nullable.NewTimeOfDay(17, 30, 0, 0, true),
+				nullable.NewTimeOfDay(9, 0, 0, 0, true),
+				nullable.NewDuration(8*time.Hour+30*time.Minute, true),
+			},
+			{
+				"earlier",
+				nullable.NewTimeOfDay(9, 0, 0, 0, true),
+				nullable.NewTimeOfDay(17, 30, 0, 0, true),
+				nullable.NewDuration(-8*time.Hour-30*time.Minute, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Sub(tc.u))
+			})
+		}
+	})
+}
+
+func TestTimeOfDay_Compare(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name          string
+			in            nullable.TimeOfDay
+			u             nullable.TimeOfDay
+			want          int
+			before, after nullable.Bool
+		}{
+			{
+				"null, null",
+				nullable.NewTimeOfDay(0, 0, 0, 0, false),
+				nullable.NewTimeOfDay(0, 0, 0, 0, false),
+				0,
+				nullable.NewBool(false, false), nullable.NewBool(false, false),
+			},
+			{
+				"null, valid",
+				nullable.NewTimeOfDay(0, 0, 0, 0, false),
+				nullable.NewTimeOfDay(0, 0, 0, 0, true),
+				-1,
+				nullable.NewBool(false, false), nullable.NewBool(false, false),
+			},
+			{
+				"before",
+				nullable.NewTimeOfDay(9, 0, 0, 0, true),
+				nullable.NewTimeOfDay(9, 0, 0, 1, true),
+				-1,
+				nullable.NewBool(true, true), nullable.NewBool(false, true),
+			},
+			{
+				"equal",
+				nullable.NewTimeOfDay(9, 0, 0, 0, true),
+				nullable.NewTimeOfDay(9, 0, 0, 0, true),
+				0,
+				nullable.NewBool(false, true), nullable.NewBool(false, true),
+			},
+			{
+				"after",
+				nullable.NewTimeOfDay(10, 0, 0, 0, true),
+				nullable.NewTimeOfDay(9, 59, 59, 0, true),
+				1,
+				nullable.NewBool(false, true), nullable.NewBool(true, true),
+			},
+			{
+				"end of the day",
+				nullable.NewTimeOfDay(24, 0, 0, 0, true),
+				nullable.NewTimeOfDay(23, 59, 59, 999999999, true),
+				1,
+				nullable.NewBool(false, true), nullable.NewBool(true, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Compare(tc.u))
+				require.Equal(t, tc.before, tc.in.Before(tc.u))
+				require.Equal(t, tc.after, tc.in.After(tc.u))
+			})
+		}
+	})
+}
+
+func TestTimeOfDay_Value(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimeOfDay
+			want string
+		}{
+			{
+				"hour 25",
+				nullable.NewTimeOfDay(25, 0, 0, 0, true),
+				"invalid time of day: 25 hours, 0 minutes, 0 seconds and 0 nanoseconds",
+			},
+			{
+				"after the end of the day",
+				nullable.NewTimeOfDay(24, 0, 0, 1, true),
+				"invalid time of day",
+			},
+			{
+				"negative minutes",
+				nullable.NewTimeOfDay(0, -1, 0, 0, true),
+				"invalid time of day",
+			},
+			{
+				"second 60",
+				nullable.NewTimeOfDay(23, 59, 60, 0, true),
+				"invalid time of day",
+			},
+			{
+				"nanosecond overflow",
+				nullable.NewTimeOfDay(0, 0, 0, 1000000000, true),
+				"invalid time of day",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.Value()
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimeOfDay
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewTimeOfDay(0, 0, 0, 0, false),
+				nil,
+			},
+			{
+				"midnight",
+				nullable.NewTimeOfDay(0, 0, 0, 0, true),
+				"00:00:00",
+			},
+			{
+				"microseconds",
+				nullable.NewTimeOfDay(18, 15, 5, 123456789, true),
+				"18:15:05.123456",
+			},
+			{
+				"end of the day",
+				nullable.NewTimeOfDay(24, 0, 0, 0, true),
+				"24:00:00",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestTimeOfDay_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"int64",
+				int64(0),
+				"unsupported source type: int64",
+			},
+			{
+				"string: empty",
+				"",
+				"invalid source",
+			},
+			{
+				"string: after the end of the day",
+				"24:00:01",
+				"invalid source",
+			},
+			{
+				"string: hour 25",
+				"25:00:00",
+				"invalid source",
+			},
+			{
+				"[]byte: without seconds",
+				[]byte("18:15"),
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.TimeOfDay
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.TimeOfDay
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewTimeOfDay(0, 0, 0, 0, false),
+			},
+			{
+				"time.Time",
+				genesis.In(jst),
+				nullable.NewTimeOfDay(3, 15, 5, 0, true),
+			},
+			{
+				"string",
+				"18:15:05",
+				nullable.NewTimeOfDay(18, 15, 5, 0, true),
+			},
+			{
+				"[]byte: nanoseconds",
+				[]byte("18:15:05.123456789"),
+				nullable.NewTimeOfDay(18, 15, 5, 123456789, true),
+			},
+			{
+				"string: end of the day",
+				"24:00:00",
+				nullable.NewTimeOfDay(24, 0, 0, 0, true),
+			},
+			{
+				"[]byte: end of the day with fractional seconds",
+				[]byte("24:00:00.000"),
+				nullable.NewTimeOfDay(24, 0, 0, 0, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.TimeOfDay
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestTimeOfDay_MarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimeOfDay
+			want string
+		}{
+			{
+				"hour 25",
+				nullable.NewTimeOfDay(25, 0, 0, 0, true),
+				"invalid time of day",
+			},
+			{
+				"minute 60",
+				nullable.NewTimeOfDay(0, 60, 0, 0, true),
+				"invalid time of day",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.MarshalJSON()
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimeOfDay
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewTimeOfDay(0, 0, 0, 0, false),
+				[]byte(`null`),
+			},
+			{
+				"seconds",
+				nullable.NewTimeOfDay(18, 15, 5, 0, true),
+				[]byte(`"18:15:05"`),
+			},
+			{
+				"microseconds",
+				nullable.NewTimeOfDay(18, 15, 5, 123456789, true),
+				[]byte(`"18:15:05.123456"`),
+			},
+			{
+				"end of the day",
+				nullable.NewTimeOfDay(24, 0, 0, 0, true),
+				[]byte(`"24:00:00"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := tc.in.MarshalJSON()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestTimeOfDay_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"empty",
+				[]byte{},
+				"",
+			},
+			{
+				"number",
+				[]byte(`65705`),
+				"",
+			},
+			{
+				"string: empty",
+				[]byte(`""`),
+				"invalid source",
+			},
+			{
+				"string: 12-hour clock",
+				[]byte(`"6:15:05 PM"`),
+				"invalid source",
+			},
+			{
+				"string: after the end of the day",
+				[]byte(`"24:00:00.000001"`),
+				`invalid source: "24:00:00.000001" is after 24:00:00`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.TimeOfDay
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.TimeOfDay
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewTimeOfDay(0, 0, 0, 0, false),
+			},
+			{
+				"string",
+				[]byte(`"18:15:05.123456"`),
+				nullable.NewTimeOfDay(18, 15, 5, 123456000, true),
+			},
+			{
+				"string: end of the day",
+				[]byte(`"24:00:00"`),
+				nullable.NewTimeOfDay(24, 0, 0, 0, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.TimeOfDay
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestTimeOfDay_Generate(t *testing.T) {
+	ns := generate[nullable.TimeOfDay](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.TimeOfDay
+	}{
+		{
+			"null",
+			nullable.NewTimeOfDay(0, 0, 0, 0, false),
+		},
+		{
+			"midnight",
+			nullable.NewTimeOfDay(0, 0, 0, 0, true),
+		},
+		{
+			"noon",
+			nullable.NewTimeOfDay(12, 0, 0, 0, true),
+		},
+		{
+			"max",
+			nullable.NewTimeOfDay(23, 59, 59, 999999000, true),
+		},
+		{
+			"end of the day",
+			nullable.NewTimeOfDay(24, 0, 0, 0, true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzTimeOfDay_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.TimeOfDay](f,
+		[]byte(""),
+		[]byte("24:00:00"),
+		[]byte("18:15"),
+		[]byte("18:15:05"),
+		[]byte("18:15:05.123456789"),
+	)
+}
+
+func FuzzTimeOfDay_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.TimeOfDay](f,
+		[]byte{},
+		[]byte(`65705`),
+		[]byte(`""`),
+		[]byte(`"6:15:05 PM"`),
+		[]byte(`null`),
+		[]byte(`"18:15:05.123456"`),
+		[]byte(`"24:00:00"`),
+	)
+}
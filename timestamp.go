@@ -4,10 +4,20 @@ import (
 	"bytes"
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/m0t0k1ch1-go/timeutil/v5"
 )
 
+// lenientMillisThreshold is the absolute value from which lenient decoding in seconds takes numbers as milliseconds.
+// It is 1e11, which is 5138-11-16 in seconds.
+const lenientMillisThreshold = 100_000_000_000
+
 // Timestamp represents a nullable timeutil.Timestamp.
 type Timestamp struct {
 	Timestamp timeutil.Timestamp
@@ -31,6 +41,58 @@ func (n Timestamp) NullableString() String {
 	return NewString(n.Timestamp.String(), true)
 }
 
+// NewTimestampFromTime returns a new Timestamp from a time.Time, truncated to whole seconds.
+func NewTimestampFromTime(t time.Time, valid bool) Timestamp {
+	if !valid {
+		return NewTimestamp(timeutil.Timestamp{}, false)
+	}
+
+	return NewTimestamp(timeutil.NewTimestampFromUnix(t.Unix()), true)
+}
+
+// Time returns the value as a Time in UTC, or null if invalid.
+func (n Timestamp) Time() Time {
+	if !n.Valid {
+		return NewTime(time.Time{}, false)
+	}
+
+	return NewTime(time.Unix(n.Timestamp.Unix(), 0).UTC(), true)
+}
+
+// Add returns the timestamp d after the value, truncated to whole seconds, or null if invalid.
+func (n Timestamp) Add(d time.Duration) Timestamp {
+	return NewTimestampFromTime(n.Time().Add(d).Time, n.Valid)
+}
+
+// Sub returns the duration from u to the value, or null if either is invalid.
+// It saturates as time.Time.Sub does.
+func (n Timestamp) Sub(u Timestamp) Duration {
+	return n.Time().Sub(u.Time())
+}
+
+// Before reports whether the value is before u, or returns null if either is invalid.
+func (n Timestamp) Before(u Timestamp) Bool {
+	return n.Time().Before(u.Time())
+}
+
+// After reports whether the value is after u, or returns null if either is invalid.
+func (n Timestamp) After(u Timestamp) Bool {
+	return n.Time().After(u.Time())
+}
+
+// Between reports whether the value is within [lo, hi], as SQL BETWEEN does.
+// It returns null if the result depends on an invalid operand,
+// e.g. false if the value is valid and after a valid hi, even if lo is invalid.
+func (n Timestamp) Between(lo Timestamp, hi Timestamp) Bool {
+	return n.Time().Between(lo.Time(), hi.Time())
+}
+
+// Truncate returns the value rounded down to a multiple of d since the zero time,
+// as time.Time.Truncate does, or null if invalid.
+func (n Timestamp) Truncate(d time.Duration) Timestamp {
+	return NewTimestampFromTime(n.Time().Truncate(d).Time, n.Valid)
+}
+
 // Value implements driver.Valuer.
 // It returns the driver.Value returned by timeutil.Timestamp.Value, or nil if invalid.
 func (n Timestamp) Value() (driver.Value, error) {
@@ -71,6 +133,7 @@ func (n Timestamp) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON implements json.Unmarshaler.
 // It accepts any JSON value supported by timeutil.Timestamp, or null.
+// TimestampLenient also accepts Unix milliseconds and RFC 3339 strings.
 func (n *Timestamp) UnmarshalJSON(b []byte) error {
 	if bytes.Equal(b, []byte("null")) {
 		n.Timestamp, n.Valid = timeutil.Timestamp{}, false
@@ -86,3 +149,75 @@ func (n *Timestamp) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// maxTimestampUnix is the Unix time of 9999-12-31T23:59:59Z.
+const maxTimestampUnix = 253402300799
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (the Unix epoch, ±1 second from it, the 32-bit overflow
+// or 9999-12-31T23:59:59Z), or a random timestamp between the epoch and 9999-12-31.
+func (Timestamp) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewTimestamp(timeutil.Timestamp{}, false))
+	}
+
+	if i, ok := generateEdge[int64](r, 0, 1, -1, math.MaxInt32, math.MaxInt32+1, maxTimestampUnix); ok {
+		return reflect.ValueOf(NewTimestamp(timeutil.NewTimestampFromUnix(i), true))
+	}
+
+	return reflect.ValueOf(NewTimestamp(timeutil.NewTimestampFromUnix(r.Int63n(maxTimestampUnix+1)), true))
+}
+
+// unmarshalJSONLenient decodes a JSON number of Unix time in unit, or an RFC 3339 string.
+// In time.Millisecond, every number is taken as milliseconds.
+// In time.Second, numbers whose absolute value is at least lenientMillisThreshold are taken as milliseconds,
+// since no timestamp of interest is that many seconds away from the epoch.
+func (n *Timestamp) unmarshalJSONLenient(b []byte, unit time.Duration) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.Timestamp, n.Valid = timeutil.Timestamp{}, false
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		return n.parseRFC3339(s)
+	}
+
+	i, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid JSON: %s is neither an integer nor an RFC 3339 string", b)
+	}
+
+	if unit == time.Millisecond || i <= -lenientMillisThreshold || i >= lenientMillisThreshold {
+		n.Timestamp, n.Valid = timestampFromUnixMilli(i), true
+
+		return nil
+	}
+
+	n.Timestamp, n.Valid = timeutil.NewTimestampFromUnix(i), true
+
+	return nil
+}
+
+func (n *Timestamp) parseRFC3339(s string) error {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	n.Timestamp, n.Valid = timeutil.NewTimestampFromUnix(t.Unix()), true
+
+	return nil
+}
+
+// timestampFromUnixMilli returns the timestamp of the Unix milliseconds i,
+// truncated toward the past to whole seconds.
+func timestampFromUnixMilli(i int64) timeutil.Timestamp {
+	sec := i / 1000
+	if i%1000 < 0 {
+		sec--
+	}
+
+	return timeutil.NewTimestampFromUnix(sec)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
 )
 
 func TestTimestamp(t *testing.T) {
@@ -23,6 +24,25 @@ func TestTimestamp(t *testing.T) {
 	require.Implements(t, (*json.Unmarshaler)(nil), &n)
 }
 
+func TestTimestamp_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Timestamp]{
+		Valid: []nullable.Timestamp{
+			nullable.NewTimestamp(timeutil.NewTimestampFromUnix(0), true),
+			nullable.NewTimestamp(timeutil.NewTimestampFromUnix(1231006505), true),
+			nullable.NewTimestamp(timeutil.NewTimestampFromUnix(-1231006505), true),
+		},
+		InvalidScan: []any{
+			time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+			uint64(math.MaxInt64) + 1,
+			[]byte{},
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`"0"`),
+			[]byte(`1231006505.0`),
+		},
+	})
+}
+
 func TestTimestamp_NullableString(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -62,6 +82,342 @@ func TestTimestamp_NullableString(t *testing.T) {
 	})
 }
 
+func TestNewTimestampFromTime(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name  string
+			in    time.Time
+			valid bool
+			want  nullable.Timestamp
+		}{
+			{
+				"null",
+				genesis,
+				false,
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+			},
+			{
+				"UTC",
+				genesis,
+				true,
+				nullable.NewTimestamp(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+			{
+				"JST with nanoseconds",
+				genesisNano.In(jst),
+				true,
+				nullable.NewTimestamp(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, nullable.NewTimestampFromTime(tc.in, tc.valid))
+			})
+		}
+	})
+}
+
+func TestTimestamp_Time(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Timestamp
+			want nullable.Time
+		}{
+			{
+				"null",
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+				nullable.NewTime(time.Time{}, false),
+			},
+			{
+				"valid",
+				nullable.NewTimestamp(timeutil.NewTimestampFromUnix(1231006505), true),
+				nullable.NewTime(genesis, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Time())
+			})
+		}
+	})
+}
+
+func TestTimestamp_Add(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Timestamp
+			d    time.Duration
+			want nullable.Timestamp
+		}{
+			{
+				"null",
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+				time.Hour,
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+			},
+			{
+				"positive",
+				nullable.NewTimestampFromTime(genesis, true),
+				time.Hour,
+				nullable.NewTimestampFromTime(genesis.Add(time.Hour), true),
+			},
+			{
+				"negative",
+				nullable.NewTimestampFromTime(genesis, true),
+				-24 * time.Hour,
+				nullable.NewTimestampFromTime(genesis.AddDate(0, 0, -1), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Add(tc.d))
+			})
+		}
+	})
+}
+
+func TestTimestamp_Sub(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			a    nullable.Timestamp
+			b    nullable.Timestamp
+			want nullable.Duration
+		}{
+			{
+				"null - valid",
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewDuration(0, false),
+			},
+			{
+				"valid - null",
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+				nullable.NewDuration(0, false),
+			},
+			{
+				"later - earlier",
+				nullable.NewTimestampFromTime(genesis.Add(90*time.Minute), true),
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewDuration(90*time.Minute, true),
+			},
+			{
+				"earlier - later",
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestampFromTime(genesis.Add(90*time.Minute), true),
+				nullable.NewDuration(-90*time.Minute, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.a.Sub(tc.b))
+			})
+		}
+	})
+}
+
+func TestTimestamp_Before(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			a    nullable.Timestamp
+			b    nullable.Timestamp
+			want nullable.Bool
+		}{
+			{
+				"null",
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewBool(false, false),
+			},
+			{
+				"before",
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestampFromTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(true, true),
+			},
+			{
+				"equal",
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"after",
+				nullable.NewTimestampFromTime(genesis.Add(time.Hour), true),
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewBool(false, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.a.Before(tc.b))
+			})
+		}
+	})
+}
+
+func TestTimestamp_After(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			a    nullable.Timestamp
+			b    nullable.Timestamp
+			want nullable.Bool
+		}{
+			{
+				"null",
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+				nullable.NewBool(false, false),
+			},
+			{
+				"before",
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestampFromTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"equal",
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"after",
+				nullable.NewTimestampFromTime(genesis.Add(time.Hour), true),
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewBool(true, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.a.After(tc.b))
+			})
+		}
+	})
+}
+
+func TestTimestamp_Between(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Timestamp
+			lo   nullable.Timestamp
+			hi   nullable.Timestamp
+			want nullable.Bool
+		}{
+			{
+				"null",
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestampFromTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(false, false),
+			},
+			{
+				"lo",
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestampFromTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(true, true),
+			},
+			{
+				"hi",
+				nullable.NewTimestampFromTime(genesis.Add(time.Hour), true),
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestampFromTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(true, true),
+			},
+			{
+				"before lo",
+				nullable.NewTimestampFromTime(genesis.Add(-time.Hour), true),
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestampFromTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"after hi",
+				nullable.NewTimestampFromTime(genesis.Add(2*time.Hour), true),
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestampFromTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"null lo",
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+				nullable.NewTimestampFromTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(false, false),
+			},
+			{
+				"null lo, after hi",
+				nullable.NewTimestampFromTime(genesis.Add(2*time.Hour), true),
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+				nullable.NewTimestampFromTime(genesis.Add(time.Hour), true),
+				nullable.NewBool(false, true),
+			},
+			{
+				"null hi, before lo",
+				nullable.NewTimestampFromTime(genesis.Add(-time.Hour), true),
+				nullable.NewTimestampFromTime(genesis, true),
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+				nullable.NewBool(false, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Between(tc.lo, tc.hi))
+			})
+		}
+	})
+}
+
+func TestTimestamp_Truncate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.Timestamp
+			d    time.Duration
+			want nullable.Timestamp
+		}{
+			{
+				"null",
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+				time.Hour,
+				nullable.NewTimestamp(timeutil.Timestamp{}, false),
+			},
+			{
+				"hour",
+				nullable.NewTimestampFromTime(genesis, true),
+				time.Hour,
+				nullable.NewTimestampFromTime(time.Date(2009, 1, 3, 18, 0, 0, 0, time.UTC), true),
+			},
+			{
+				"day",
+				nullable.NewTimestampFromTime(genesis, true),
+				24 * time.Hour,
+				nullable.NewTimestampFromTime(time.Date(2009, 1, 3, 0, 0, 0, 0, time.UTC), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Truncate(tc.d))
+			})
+		}
+	})
+}
+
 func TestTimestamp_Value(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -325,3 +681,63 @@ func TestTimestamp_UnmarshalJSON(t *testing.T) {
 		}
 	})
 }
+
+func TestTimestamp_Generate(t *testing.T) {
+	ns := generate[nullable.Timestamp](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Timestamp
+	}{
+		{
+			"null",
+			nullable.NewTimestamp(timeutil.Timestamp{}, false),
+		},
+		{
+			"epoch",
+			nullable.NewTimestamp(timeutil.NewTimestampFromUnix(0), true),
+		},
+		{
+			"before epoch",
+			nullable.NewTimestamp(timeutil.NewTimestampFromUnix(-1), true),
+		},
+		{
+			"2038",
+			nullable.NewTimestamp(timeutil.NewTimestampFromUnix(math.MaxInt32+1), true),
+		},
+		{
+			"9999-12-31",
+			nullable.NewTimestamp(timeutil.NewTimestampFromUnix(253402300799), true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzTimestamp_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Timestamp](f,
+		[]byte{},
+		[]byte("1231006505"),
+	)
+}
+
+func FuzzTimestamp_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Timestamp](f,
+		[]byte{},
+		[]byte(`9223372036854775808`),
+		[]byte(`1231006505.0`),
+		[]byte(`1231006505e0`),
+		[]byte(`""`),
+		[]byte(`"0"`),
+		[]byte(`"1231006505"`),
+		[]byte(`"-1231006505"`),
+		[]byte(`null`),
+		[]byte(`0`),
+		[]byte(`1231006505`),
+		[]byte(`-1231006505`),
+	)
+}
@@ -0,0 +1,168 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"time"
+
+	"github.com/m0t0k1ch1-go/timeutil/v5"
+)
+
+// TimestampLenient represents a nullable timeutil.Timestamp encoded in JSON as Timestamp is,
+// that decodes any JSON form of Timestamp, TimestampMillis and TimestampRFC3339.
+// It takes JSON numbers as Unix seconds, or as milliseconds if their absolute value is at least 1e11.
+// It is stored and scanned exactly as Timestamp, and converts to and from it.
+type TimestampLenient Timestamp
+
+// NewTimestampLenient returns a new TimestampLenient.
+func NewTimestampLenient(ts timeutil.Timestamp, valid bool) TimestampLenient {
+	return TimestampLenient(NewTimestamp(ts, valid))
+}
+
+// NullableString returns the same String as Timestamp.NullableString.
+func (n TimestampLenient) NullableString() String {
+	return Timestamp(n).NullableString()
+}
+
+// Value implements driver.Valuer.
+// It returns the same driver.Value as Timestamp.Value.
+func (n TimestampLenient) Value() (driver.Value, error) {
+	return Timestamp(n).Value()
+}
+
+// Scan implements sql.Scanner.
+// It accepts the same sources as Timestamp.Scan.
+func (n *TimestampLenient) Scan(src any) error {
+	return (*Timestamp)(n).Scan(src)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the same JSON as Timestamp.MarshalJSON, or as TimestampMillis.MarshalJSON
+// if the value is at least 1e11 seconds away from the epoch, which UnmarshalJSON would take as milliseconds.
+func (n TimestampLenient) MarshalJSON() ([]byte, error) {
+	if sec := n.Timestamp.Unix(); n.Valid && (sec <= -lenientMillisThreshold || sec >= lenientMillisThreshold) {
+		return TimestampMillis(n).MarshalJSON()
+	}
+
+	return Timestamp(n).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number of Unix seconds or milliseconds, a JSON string in RFC 3339 format,
+// truncated to whole seconds, or null.
+func (n *TimestampLenient) UnmarshalJSON(b []byte) error {
+	return (*Timestamp)(n).unmarshalJSONLenient(b, time.Second)
+}
+
+// Generate implements quick.Generator.
+// It returns the same values as Timestamp.Generate.
+func (TimestampLenient) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(TimestampLenient(Timestamp{}.Generate(r, size).Interface().(Timestamp)))
+}
+
+// TimestampMillisLenient represents a nullable timeutil.Timestamp encoded in JSON as TimestampMillis is,
+// that decodes any JSON form of Timestamp, TimestampMillis and TimestampRFC3339.
+// Unlike TimestampLenient, it takes every JSON number as Unix milliseconds.
+// It is stored and scanned exactly as Timestamp, and converts to and from it.
+type TimestampMillisLenient Timestamp
+
+// NewTimestampMillisLenient returns a new TimestampMillisLenient.
+func NewTimestampMillisLenient(ts timeutil.Timestamp, valid bool) TimestampMillisLenient {
+	return TimestampMillisLenient(NewTimestamp(ts, valid))
+}
+
+// NullableString returns the same String as TimestampMillis.NullableString.
+func (n TimestampMillisLenient) NullableString() String {
+	return TimestampMillis(n).NullableString()
+}
+
+// Value implements driver.Valuer.
+// It returns the same driver.Value as Timestamp.Value.
+func (n TimestampMillisLenient) Value() (driver.Value, error) {
+	return Timestamp(n).Value()
+}
+
+// Scan implements sql.Scanner.
+// It accepts the same sources as Timestamp.Scan.
+func (n *TimestampMillisLenient) Scan(src any) error {
+	return (*Timestamp)(n).Scan(src)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the same JSON as TimestampMillis.MarshalJSON.
+func (n TimestampMillisLenient) MarshalJSON() ([]byte, error) {
+	return TimestampMillis(n).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number of Unix milliseconds, a JSON string in RFC 3339 format,
+// truncated to whole seconds, or null.
+func (n *TimestampMillisLenient) UnmarshalJSON(b []byte) error {
+	return (*Timestamp)(n).unmarshalJSONLenient(b, time.Millisecond)
+}
+
+// Generate implements quick.Generator.
+// It returns the same values as Timestamp.Generate.
+func (TimestampMillisLenient) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(TimestampMillisLenient(Timestamp{}.Generate(r, size).Interface().(Timestamp)))
+}
+
+// TimestampRFC3339Lenient represents a nullable timeutil.Timestamp encoded in JSON as TimestampRFC3339 is,
+// that decodes any JSON form of Timestamp, TimestampMillis and TimestampRFC3339.
+// It takes JSON numbers as TimestampLenient does.
+// It is stored and scanned exactly as Timestamp, and converts to and from it.
+type TimestampRFC3339Lenient Timestamp
+
+// NewTimestampRFC3339Lenient returns a new TimestampRFC3339Lenient.
+func NewTimestampRFC3339Lenient(ts timeutil.Timestamp, valid bool) TimestampRFC3339Lenient {
+	return TimestampRFC3339Lenient(NewTimestamp(ts, valid))
+}
+
+// NullableString returns the same String as TimestampRFC3339.NullableString.
+func (n TimestampRFC3339Lenient) NullableString() String {
+	return TimestampRFC3339(n).NullableString()
+}
+
+// Value implements driver.Valuer.
+// It returns the same driver.Value as Timestamp.Value.
+func (n TimestampRFC3339Lenient) Value() (driver.Value, error) {
+	return Timestamp(n).Value()
+}
+
+// Scan implements sql.Scanner.
+// It accepts the same sources as Timestamp.Scan.
+func (n *TimestampRFC3339Lenient) Scan(src any) error {
+	return (*Timestamp)(n).Scan(src)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the same JSON as TimestampRFC3339.MarshalJSON.
+func (n TimestampRFC3339Lenient) MarshalJSON() ([]byte, error) {
+	return TimestampRFC3339(n).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts the same JSON values as TimestampLenient.UnmarshalJSON,
+// except numbers outside the years 0 to 9999, which RFC 3339 cannot represent.
+func (n *TimestampRFC3339Lenient) UnmarshalJSON(b []byte) error {
+	var ts Timestamp
+	if err := ts.unmarshalJSONLenient(b, time.Second); err != nil {
+		return err
+	}
+
+	if y := ts.Time().Time.Year(); ts.Valid && (y < 0 || y > 9999) {
+		return fmt.Errorf("invalid source: year %d is outside of [0, 9999]", y)
+	}
+
+	*n = TimestampRFC3339Lenient(ts)
+
+	return nil
+}
+
+// Generate implements quick.Generator.
+// It returns the same values as Timestamp.Generate.
+func (TimestampRFC3339Lenient) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(TimestampRFC3339Lenient(Timestamp{}.Generate(r, size).Interface().(Timestamp)))
+}
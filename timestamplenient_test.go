@@ -0,0 +1,374 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/m0t0k1ch1-go/timeutil/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestTimestampLenient(t *testing.T) {
+	var n nullable.TimestampLenient
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestTimestampLenient_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.TimestampLenient]{
+		Valid: []nullable.TimestampLenient{
+			nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(0), true),
+			nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(1231006505), true),
+			nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(-1231006505), true),
+			nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(253402300799), true),
+		},
+		InvalidScan: []any{
+			uint64(math.MaxInt64) + 1,
+			[]byte{},
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`1231006505.0`),
+			[]byte(`"1231006505"`),
+		},
+	})
+}
+
+func TestTimestampLenient_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimestampLenient
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewTimestampLenient(timeutil.Timestamp{}, false),
+				[]byte(`null`),
+			},
+			{
+				"seconds",
+				nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(99999999999), true),
+				[]byte(`99999999999`),
+			},
+			{
+				"milliseconds",
+				nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(100000000000), true),
+				[]byte(`100000000000000`),
+			},
+			{
+				"negative milliseconds",
+				nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(-100000000000), true),
+				[]byte(`-100000000000000`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestTimestampLenient_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"number: fractional",
+				[]byte(`1231006505.0`),
+				"invalid JSON",
+			},
+			{
+				"string: decimal",
+				[]byte(`"1231006505"`),
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.TimestampLenient
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.TimestampLenient
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewTimestampLenient(timeutil.Timestamp{}, false),
+			},
+			{
+				"number: seconds",
+				[]byte(`1231006505`),
+				nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+			{
+				"number: largest seconds",
+				[]byte(`99999999999`),
+				nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(99999999999), true),
+			},
+			{
+				"number: milliseconds",
+				[]byte(`1231006505123`),
+				nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+			{
+				"number: negative milliseconds",
+				[]byte(`-1231006505123`),
+				nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(-1231006506), true),
+			},
+			{
+				"string: RFC 3339",
+				[]byte(`"2009-01-04T03:15:05+09:00"`),
+				nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.TimestampLenient
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.Valid, n.Valid)
+				require.Equal(t, tc.want.Timestamp.Unix(), n.Timestamp.Unix())
+			})
+		}
+	})
+}
+
+func TestTimestampLenient_Generate(t *testing.T) {
+	ns := generate[nullable.TimestampLenient](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.TimestampLenient
+	}{
+		{
+			"null",
+			nullable.NewTimestampLenient(timeutil.Timestamp{}, false),
+		},
+		{
+			"epoch",
+			nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(0), true),
+		},
+		{
+			"9999-12-31",
+			nullable.NewTimestampLenient(timeutil.NewTimestampFromUnix(253402300799), true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzTimestampLenient_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.TimestampLenient](f,
+		[]byte(`null`),
+		[]byte(`1231006505`),
+		[]byte(`1231006505123`),
+		[]byte(`"2009-01-03T18:15:05Z"`),
+	)
+}
+
+func TestTimestampMillisLenient(t *testing.T) {
+	var n nullable.TimestampMillisLenient
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestTimestampMillisLenient_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.TimestampMillisLenient]{
+		Valid: []nullable.TimestampMillisLenient{
+			nullable.NewTimestampMillisLenient(timeutil.NewTimestampFromUnix(0), true),
+			nullable.NewTimestampMillisLenient(timeutil.NewTimestampFromUnix(1231006505), true),
+			nullable.NewTimestampMillisLenient(timeutil.NewTimestampFromUnix(253402300799), true),
+		},
+		InvalidScan: []any{
+			uint64(math.MaxInt64) + 1,
+			[]byte{},
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`1231006505000.0`),
+			[]byte(`"1231006505000"`),
+		},
+	})
+}
+
+func TestTimestampMillisLenient_UnmarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.TimestampMillisLenient
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewTimestampMillisLenient(timeutil.Timestamp{}, false),
+			},
+			{
+				"number: milliseconds",
+				[]byte(`1231006505123`),
+				nullable.NewTimestampMillisLenient(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+			{
+				"number: small milliseconds",
+				[]byte(`1231006505`),
+				nullable.NewTimestampMillisLenient(timeutil.NewTimestampFromUnix(1231006), true),
+			},
+			{
+				"string: RFC 3339",
+				[]byte(`"2009-01-03T18:15:05Z"`),
+				nullable.NewTimestampMillisLenient(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.TimestampMillisLenient
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.Valid, n.Valid)
+				require.Equal(t, tc.want.Timestamp.Unix(), n.Timestamp.Unix())
+			})
+		}
+	})
+}
+
+func FuzzTimestampMillisLenient_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.TimestampMillisLenient](f,
+		[]byte(`null`),
+		[]byte(`1231006505`),
+		[]byte(`1231006505123`),
+		[]byte(`"2009-01-03T18:15:05Z"`),
+	)
+}
+
+func TestTimestampRFC3339Lenient(t *testing.T) {
+	var n nullable.TimestampRFC3339Lenient
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestTimestampRFC3339Lenient_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.TimestampRFC3339Lenient]{
+		Valid: []nullable.TimestampRFC3339Lenient{
+			nullable.NewTimestampRFC3339Lenient(timeutil.NewTimestampFromUnix(0), true),
+			nullable.NewTimestampRFC3339Lenient(timeutil.NewTimestampFromUnix(1231006505), true),
+			nullable.NewTimestampRFC3339Lenient(timeutil.NewTimestampFromUnix(253402300799), true),
+		},
+		InvalidScan: []any{
+			uint64(math.MaxInt64) + 1,
+			[]byte{},
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`"2009-01-03 18:15:05"`),
+		},
+	})
+}
+
+func TestTimestampRFC3339Lenient_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"number: after 9999",
+				[]byte(`253402300800000`),
+				"invalid source: year 10000 is outside of [0, 9999]",
+			},
+			{
+				"number: before 0",
+				[]byte(`-62167219201000`),
+				"invalid source: year -1 is outside of [0, 9999]",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.TimestampRFC3339Lenient
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.TimestampRFC3339Lenient
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewTimestampRFC3339Lenient(timeutil.Timestamp{}, false),
+			},
+			{
+				"number: seconds",
+				[]byte(`1231006505`),
+				nullable.NewTimestampRFC3339Lenient(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+			{
+				"number: milliseconds",
+				[]byte(`1231006505000`),
+				nullable.NewTimestampRFC3339Lenient(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+			{
+				"string: RFC 3339",
+				[]byte(`"2009-01-03T18:15:05Z"`),
+				nullable.NewTimestampRFC3339Lenient(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.TimestampRFC3339Lenient
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.Valid, n.Valid)
+				require.Equal(t, tc.want.Timestamp.Unix(), n.Timestamp.Unix())
+			})
+		}
+	})
+}
+
+func FuzzTimestampRFC3339Lenient_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.TimestampRFC3339Lenient](f,
+		[]byte(`null`),
+		[]byte(`1231006505`),
+		[]byte(`1231006505000`),
+		[]byte(`"2009-01-03T18:15:05Z"`),
+	)
+}
@@ -0,0 +1,84 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"strconv"
+
+	"github.com/m0t0k1ch1-go/timeutil/v5"
+)
+
+// TimestampMillis represents a nullable timeutil.Timestamp encoded in JSON as Unix milliseconds.
+// It is stored and scanned exactly as Timestamp, and converts to and from it.
+type TimestampMillis Timestamp
+
+// NewTimestampMillis returns a new TimestampMillis.
+func NewTimestampMillis(ts timeutil.Timestamp, valid bool) TimestampMillis {
+	return TimestampMillis(NewTimestamp(ts, valid))
+}
+
+// NullableString returns the value as a String of Unix milliseconds.
+func (n TimestampMillis) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(strconv.FormatInt(n.Timestamp.Unix()*1000, 10), true)
+}
+
+// Value implements driver.Valuer.
+// It returns the same driver.Value as Timestamp.Value.
+func (n TimestampMillis) Value() (driver.Value, error) {
+	return Timestamp(n).Value()
+}
+
+// Scan implements sql.Scanner.
+// It accepts the same sources as Timestamp.Scan.
+func (n *TimestampMillis) Scan(src any) error {
+	return (*Timestamp)(n).Scan(src)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON number of Unix milliseconds, or null if invalid.
+func (n TimestampMillis) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	sec := n.Timestamp.Unix()
+	if sec > math.MaxInt64/1000 || sec < math.MinInt64/1000 {
+		return nil, errors.New("timestamp overflows int64 milliseconds")
+	}
+
+	return []byte(strconv.FormatInt(sec*1000, 10)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number of Unix milliseconds, truncated to whole seconds, or null.
+func (n *TimestampMillis) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.Timestamp, n.Valid = timeutil.Timestamp{}, false
+
+		return nil
+	}
+
+	i, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid JSON: %s is not an integer", b)
+	}
+
+	n.Timestamp, n.Valid = timestampFromUnixMilli(i), true
+
+	return nil
+}
+
+// Generate implements quick.Generator.
+// It returns the same values as Timestamp.Generate.
+func (TimestampMillis) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(TimestampMillis(Timestamp{}.Generate(r, size).Interface().(Timestamp)))
+}
@@ -0,0 +1,257 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/m0t0k1ch1-go/timeutil/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestTimestampMillis(t *testing.T) {
+	var n nullable.TimestampMillis
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestTimestampMillis_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.TimestampMillis]{
+		Valid: []nullable.TimestampMillis{
+			nullable.NewTimestampMillis(timeutil.NewTimestampFromUnix(0), true),
+			nullable.NewTimestampMillis(timeutil.NewTimestampFromUnix(1231006505), true),
+			nullable.NewTimestampMillis(timeutil.NewTimestampFromUnix(-1231006505), true),
+		},
+		InvalidScan: []any{
+			uint64(math.MaxInt64) + 1,
+			[]byte{},
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`"1231006505000"`),
+			[]byte(`1231006505000.0`),
+		},
+	})
+}
+
+func TestTimestampMillis_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimestampMillis
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewTimestampMillis(timeutil.Timestamp{}, false),
+				nullable.NewString("", false),
+			},
+			{
+				"positive",
+				nullable.NewTimestampMillis(timeutil.NewTimestampFromUnix(1231006505), true),
+				nullable.NewString("1231006505000", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				s := tc.in.NullableString()
+				require.Equal(t, tc.want, s)
+			})
+		}
+	})
+}
+
+func TestTimestampMillis_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimestampMillis
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewTimestampMillis(timeutil.Timestamp{}, false),
+				nil,
+			},
+			{
+				"positive",
+				nullable.NewTimestampMillis(timeutil.NewTimestampFromUnix(1231006505), true),
+				int64(1231006505),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestTimestampMillis_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimestampMillis
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewTimestampMillis(timeutil.Timestamp{}, false),
+				[]byte(`null`),
+			},
+			{
+				"zero",
+				nullable.NewTimestampMillis(timeutil.NewTimestampFromUnix(0), true),
+				[]byte(`0`),
+			},
+			{
+				"positive",
+				nullable.NewTimestampMillis(timeutil.NewTimestampFromUnix(1231006505), true),
+				[]byte(`1231006505000`),
+			},
+			{
+				"negative",
+				nullable.NewTimestampMillis(timeutil.NewTimestampFromUnix(-1231006505), true),
+				[]byte(`-1231006505000`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestTimestampMillis_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"number: fractional",
+				[]byte(`1231006505000.0`),
+				"invalid JSON",
+			},
+			{
+				"string: decimal",
+				[]byte(`"1231006505000"`),
+				"invalid JSON",
+			},
+			{
+				"string: RFC 3339",
+				[]byte(`"2009-01-03T18:15:05Z"`),
+				"invalid JSON",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.TimestampMillis
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.TimestampMillis
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewTimestampMillis(timeutil.Timestamp{}, false),
+			},
+			{
+				"number",
+				[]byte(`1231006505000`),
+				nullable.NewTimestampMillis(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+			{
+				"number: sub-second",
+				[]byte(`1231006505999`),
+				nullable.NewTimestampMillis(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+			{
+				"number: negative sub-second",
+				[]byte(`-1`),
+				nullable.NewTimestampMillis(timeutil.NewTimestampFromUnix(-1), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.TimestampMillis
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.Valid, n.Valid)
+				require.Equal(t, tc.want.Timestamp.Unix(), n.Timestamp.Unix())
+			})
+		}
+	})
+}
+
+func TestTimestampMillis_Generate(t *testing.T) {
+	ns := generate[nullable.TimestampMillis](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.TimestampMillis
+	}{
+		{
+			"null",
+			nullable.NewTimestampMillis(timeutil.Timestamp{}, false),
+		},
+		{
+			"epoch",
+			nullable.NewTimestampMillis(timeutil.NewTimestampFromUnix(0), true),
+		},
+		{
+			"9999-12-31",
+			nullable.NewTimestampMillis(timeutil.NewTimestampFromUnix(253402300799), true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzTimestampMillis_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.TimestampMillis](f,
+		[]byte{},
+		[]byte("1231006505"),
+	)
+}
+
+func FuzzTimestampMillis_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.TimestampMillis](f,
+		[]byte(`1231006505000.0`),
+		[]byte(`"1231006505000"`),
+		[]byte(`"2009-01-03T18:15:05Z"`),
+		[]byte(`null`),
+		[]byte(`1231006505000`),
+		[]byte(`1231006505999`),
+		[]byte(`-1`),
+	)
+}
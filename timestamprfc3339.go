@@ -0,0 +1,77 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"time"
+
+	"github.com/m0t0k1ch1-go/timeutil/v5"
+)
+
+// TimestampRFC3339 represents a nullable timeutil.Timestamp encoded in JSON as an RFC 3339 string in UTC.
+// It is stored and scanned exactly as Timestamp, and converts to and from it.
+type TimestampRFC3339 Timestamp
+
+// NewTimestampRFC3339 returns a new TimestampRFC3339.
+func NewTimestampRFC3339(ts timeutil.Timestamp, valid bool) TimestampRFC3339 {
+	return TimestampRFC3339(NewTimestamp(ts, valid))
+}
+
+// NullableString returns the value as a String in RFC 3339 format.
+func (n TimestampRFC3339) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(Timestamp(n).Time().Time.Format(time.RFC3339), true)
+}
+
+// Value implements driver.Valuer.
+// It returns the same driver.Value as Timestamp.Value.
+func (n TimestampRFC3339) Value() (driver.Value, error) {
+	return Timestamp(n).Value()
+}
+
+// Scan implements sql.Scanner.
+// It accepts the same sources as Timestamp.Scan.
+func (n *TimestampRFC3339) Scan(src any) error {
+	return (*Timestamp)(n).Scan(src)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in RFC 3339 format, or null if invalid.
+// It returns an error if the year is outside [0, 9999].
+func (n TimestampRFC3339) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(Timestamp(n).Time().Time)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string in RFC 3339 format, truncated to whole seconds, or null.
+func (n *TimestampRFC3339) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.Timestamp, n.Valid = timeutil.Timestamp{}, false
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	return (*Timestamp)(n).parseRFC3339(s)
+}
+
+// Generate implements quick.Generator.
+// It returns the same values as Timestamp.Generate.
+func (TimestampRFC3339) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(TimestampRFC3339(Timestamp{}.Generate(r, size).Interface().(Timestamp)))
+}
@@ -0,0 +1,257 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/m0t0k1ch1-go/timeutil/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestTimestampRFC3339(t *testing.T) {
+	var n nullable.TimestampRFC3339
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestTimestampRFC3339_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.TimestampRFC3339]{
+		Valid: []nullable.TimestampRFC3339{
+			nullable.NewTimestampRFC3339(timeutil.NewTimestampFromUnix(0), true),
+			nullable.NewTimestampRFC3339(timeutil.NewTimestampFromUnix(1231006505), true),
+			nullable.NewTimestampRFC3339(timeutil.NewTimestampFromUnix(-1231006505), true),
+		},
+		InvalidScan: []any{
+			uint64(math.MaxInt64) + 1,
+			[]byte{},
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`1231006505`),
+			[]byte(`"2009-01-03 18:15:05"`),
+		},
+	})
+}
+
+func TestTimestampRFC3339_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimestampRFC3339
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewTimestampRFC3339(timeutil.Timestamp{}, false),
+				nullable.NewString("", false),
+			},
+			{
+				"positive",
+				nullable.NewTimestampRFC3339(timeutil.NewTimestampFromUnix(1231006505), true),
+				nullable.NewString("2009-01-03T18:15:05Z", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				s := tc.in.NullableString()
+				require.Equal(t, tc.want, s)
+			})
+		}
+	})
+}
+
+func TestTimestampRFC3339_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimestampRFC3339
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewTimestampRFC3339(timeutil.Timestamp{}, false),
+				nil,
+			},
+			{
+				"positive",
+				nullable.NewTimestampRFC3339(timeutil.NewTimestampFromUnix(1231006505), true),
+				int64(1231006505),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestTimestampRFC3339_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.TimestampRFC3339
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewTimestampRFC3339(timeutil.Timestamp{}, false),
+				[]byte(`null`),
+			},
+			{
+				"zero",
+				nullable.NewTimestampRFC3339(timeutil.NewTimestampFromUnix(0), true),
+				[]byte(`"1970-01-01T00:00:00Z"`),
+			},
+			{
+				"positive",
+				nullable.NewTimestampRFC3339(timeutil.NewTimestampFromUnix(1231006505), true),
+				[]byte(`"2009-01-03T18:15:05Z"`),
+			},
+			{
+				"negative",
+				nullable.NewTimestampRFC3339(timeutil.NewTimestampFromUnix(-1231006505), true),
+				[]byte(`"1930-12-29T05:44:55Z"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestTimestampRFC3339_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"number",
+				[]byte(`1231006505`),
+				"invalid JSON",
+			},
+			{
+				"string: empty",
+				[]byte(`""`),
+				"invalid source",
+			},
+			{
+				"string: MySQL DATETIME",
+				[]byte(`"2009-01-03 18:15:05"`),
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.TimestampRFC3339
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.TimestampRFC3339
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewTimestampRFC3339(timeutil.Timestamp{}, false),
+			},
+			{
+				"string",
+				[]byte(`"2009-01-03T18:15:05Z"`),
+				nullable.NewTimestampRFC3339(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+			{
+				"string: offset",
+				[]byte(`"2009-01-04T03:15:05+09:00"`),
+				nullable.NewTimestampRFC3339(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+			{
+				"string: sub-second",
+				[]byte(`"2009-01-03T18:15:05.999Z"`),
+				nullable.NewTimestampRFC3339(timeutil.NewTimestampFromUnix(1231006505), true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.TimestampRFC3339
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want.Valid, n.Valid)
+				require.Equal(t, tc.want.Timestamp.Unix(), n.Timestamp.Unix())
+			})
+		}
+	})
+}
+
+func TestTimestampRFC3339_Generate(t *testing.T) {
+	ns := generate[nullable.TimestampRFC3339](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.TimestampRFC3339
+	}{
+		{
+			"null",
+			nullable.NewTimestampRFC3339(timeutil.Timestamp{}, false),
+		},
+		{
+			"epoch",
+			nullable.NewTimestampRFC3339(timeutil.NewTimestampFromUnix(0), true),
+		},
+		{
+			"9999-12-31",
+			nullable.NewTimestampRFC3339(timeutil.NewTimestampFromUnix(253402300799), true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzTimestampRFC3339_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.TimestampRFC3339](f,
+		[]byte{},
+		[]byte("1231006505"),
+	)
+}
+
+func FuzzTimestampRFC3339_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.TimestampRFC3339](f,
+		[]byte(`1231006505`),
+		[]byte(`""`),
+		[]byte(`"2009-01-03 18:15:05"`),
+		[]byte(`null`),
+		[]byte(`"2009-01-03T18:15:05Z"`),
+		[]byte(`"2009-01-04T03:15:05+09:00"`),
+		[]byte(`"2009-01-03T18:15:05.999Z"`),
+	)
+}
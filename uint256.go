@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"database/sql/driver"
 	"encoding/json"
+	"math/big"
+	"math/rand"
+	"reflect"
 
 	"github.com/m0t0k1ch1-go/bigutil/v3"
 )
@@ -86,3 +89,28 @@ func (n *Uint256) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (0, 1, 2^64-1, 2^255 or 2^256-1), or a random uint256.
+func (Uint256) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewUint256(bigutil.Uint256{}, false))
+	}
+
+	one := big.NewInt(1)
+
+	if x, ok := generateEdge(r,
+		new(big.Int),
+		one,
+		new(big.Int).Sub(new(big.Int).Lsh(one, 64), one),
+		new(big.Int).Lsh(one, 255),
+		new(big.Int).Sub(new(big.Int).Lsh(one, 256), one),
+	); ok {
+		return reflect.ValueOf(NewUint256(bigutil.MustNewUint256(x), true))
+	}
+
+	b := make([]byte, r.Intn(33))
+	r.Read(b)
+
+	return reflect.ValueOf(NewUint256(bigutil.MustNewUint256(new(big.Int).SetBytes(b)), true))
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
 )
 
 var (
@@ -27,6 +28,26 @@ func TestUint256(t *testing.T) {
 	require.Implements(t, (*json.Unmarshaler)(nil), &n)
 }
 
+func TestUint256_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Uint256]{
+		Valid: []nullable.Uint256{
+			nullable.NewUint256(bigutil.NewUint256FromUint64(0), true),
+			nullable.NewUint256(bigutil.NewUint256FromUint64(1), true),
+			nullable.NewUint256(bigutil.MustNewUint256(maxUint256), true),
+		},
+		InvalidScan: []any{
+			int64(0),
+			[]byte{},
+			append([]byte{0x01}, bytes.Repeat([]byte{0x00}, 32)...),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`-1`),
+			[]byte(`"0x"`),
+			[]byte(`"0x1` + strings.Repeat("0", 64) + `"`),
+		},
+	})
+}
+
 func TestUint256_NullableString(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -364,3 +385,81 @@ func TestUint256_UnmarshalJSON(t *testing.T) {
 		}
 	})
 }
+
+func TestUint256_Generate(t *testing.T) {
+	var ns []nullable.String
+	for _, n := range generate[nullable.Uint256](t, 1000) {
+		ns = append(ns, n.NullableString())
+	}
+
+	tcs := []struct {
+		name string
+		want nullable.String
+	}{
+		{
+			"null",
+			nullable.NewString("", false),
+		},
+		{
+			"0",
+			nullable.NewString("0x0", true),
+		},
+		{
+			"1",
+			nullable.NewString("0x1", true),
+		},
+		{
+			"2^64-1",
+			nullable.NewString("0xffffffffffffffff", true),
+		},
+		{
+			"2^255",
+			nullable.NewString("0x8"+strings.Repeat("0", 63), true),
+		},
+		{
+			"2^256-1",
+			nullable.NewString("0x"+strings.Repeat("f", 64), true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzUint256_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Uint256](f,
+		[]byte{},
+		[]byte{0x00},
+		[]byte{0x01},
+	)
+}
+
+func FuzzUint256_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Uint256](f,
+		[]byte{},
+		[]byte(`-1`),
+		[]byte(`115792089237316195423570985008687907853269984665640564039457584007913129639936`),
+		[]byte(`0.0`),
+		[]byte(`0e0`),
+		[]byte(`""`),
+		[]byte(`"invalid"`),
+		[]byte(`"-1"`),
+		[]byte(`"0x"`),
+		[]byte(`"0x\x"`),
+		[]byte(`"0xg"`),
+		[]byte(`"0x1`+strings.Repeat("0", 64)+`"`),
+		[]byte(`null`),
+		[]byte(`0`),
+		[]byte(`1`),
+		[]byte(`115792089237316195423570985008687907853269984665640564039457584007913129639935`),
+		[]byte(`"0"`),
+		[]byte(`"1"`),
+		[]byte(`"115792089237316195423570985008687907853269984665640564039457584007913129639935"`),
+		[]byte(`"0x0"`),
+		[]byte(`"0x1"`),
+		[]byte(`"0x`+strings.Repeat("fF", 32)+`"`),
+	)
+}
@@ -6,6 +6,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
 	"strconv"
 )
 
@@ -128,3 +131,18 @@ func (n *Uint64) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (0, 1, math.MaxInt64, math.MaxInt64+1 or math.MaxUint64),
+// or a random uint64.
+func (Uint64) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewUint64(0, false))
+	}
+
+	if i, ok := generateEdge[uint64](r, 0, 1, math.MaxInt64, math.MaxInt64+1, math.MaxUint64); ok {
+		return reflect.ValueOf(NewUint64(i, true))
+	}
+
+	return reflect.ValueOf(NewUint64(r.Uint64(), true))
+}
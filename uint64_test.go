@@ -8,8 +8,29 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
 )
 
+func TestUint64_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.Uint64]{
+		Valid: []nullable.Uint64{
+			nullable.NewUint64(0, true),
+			nullable.NewUint64(1, true),
+			nullable.NewUint64(math.MaxUint64, true),
+		},
+		InvalidScan: []any{
+			int64(-1),
+			[]byte{},
+			"0",
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`-1`),
+			[]byte(`18446744073709551616`),
+			[]byte(`"0"`),
+		},
+	})
+}
+
 func TestNewUint64FromUint64Ptr(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -375,3 +396,67 @@ func TestUint64_UnmarshalJSON(t *testing.T) {
 		}
 	})
 }
+
+func TestUint64_Generate(t *testing.T) {
+	ns := generate[nullable.Uint64](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.Uint64
+	}{
+		{
+			"null",
+			nullable.NewUint64(0, false),
+		},
+		{
+			"0",
+			nullable.NewUint64(0, true),
+		},
+		{
+			"1",
+			nullable.NewUint64(1, true),
+		},
+		{
+			"max int64",
+			nullable.NewUint64(math.MaxInt64, true),
+		},
+		{
+			"max int64 + 1",
+			nullable.NewUint64(math.MaxInt64+1, true),
+		},
+		{
+			"max",
+			nullable.NewUint64(math.MaxUint64, true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzUint64_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.Uint64](f,
+		[]byte{},
+		[]byte("invalid"),
+		[]byte("18446744073709551616"),
+		[]byte("0"),
+		[]byte("1"),
+		[]byte("18446744073709551615"),
+	)
+}
+
+func FuzzUint64_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.Uint64](f,
+		[]byte(`true`),
+		[]byte(`-1`),
+		[]byte(`18446744073709551616`),
+		[]byte(`"0"`),
+		[]byte(`null`),
+		[]byte(`0`),
+		[]byte(`1`),
+		[]byte(`18446744073709551615`),
+	)
+}
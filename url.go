@@ -0,0 +1,271 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// URLPolicy restricts the schemes and hosts of URLs.
+// URLOf enforces one when scanned and decoded from JSON.
+// The zero URLPolicy allows any URL.
+type URLPolicy struct {
+	// Schemes is the set of allowed schemes, such as "https", "wss", "ipfs" or "s3".
+	// Nil means any scheme.
+	Schemes []string
+
+	// AllowedHosts is the set of allowed hosts.
+	// An entry beginning with a dot, such as ".example.com", matches any subdomain of the rest.
+	// Entries and hosts match regardless of case and of a trailing dot, so "evil.com" matches "EVIL.com.".
+	// Nil means any host.
+	AllowedHosts []string
+
+	// DeniedHosts is the set of denied hosts, in the form of AllowedHosts.
+	// It takes precedence over AllowedHosts.
+	// Nil means no host.
+	DeniedHosts []string
+}
+
+// Parse returns a new valid URL parsed from s as ParseURL does,
+// and returns an error if its scheme or host is not allowed by p.
+func (p URLPolicy) Parse(s string) (URL, error) {
+	n, err := ParseURL(s)
+	if err != nil {
+		return URL{}, err
+	}
+
+	if err := p.check(&n.URL); err != nil {
+		return URL{}, fmt.Errorf("invalid URL %q: %w", s, err)
+	}
+
+	return n, nil
+}
+
+// Check returns an error if n is valid and its scheme or host is not allowed by p.
+func (p URLPolicy) Check(n URL) error {
+	if !n.Valid {
+		return nil
+	}
+
+	if err := p.check(&n.URL); err != nil {
+		return fmt.Errorf("invalid URL %q: %w", n.URL.String(), err)
+	}
+
+	return nil
+}
+
+func (p URLPolicy) check(u *url.URL) error {
+	scheme := strings.ToLower(u.Scheme)
+	if p.Schemes != nil && !slices.ContainsFunc(p.Schemes, func(s string) bool { return strings.ToLower(s) == scheme }) {
+		return fmt.Errorf("scheme %q is not allowed", scheme)
+	}
+
+	host := normalizeURLHost(u.Hostname())
+	if matchURLHost(p.DeniedHosts, host) || p.AllowedHosts != nil && !matchURLHost(p.AllowedHosts, host) {
+		return fmt.Errorf("host %q is not allowed", host)
+	}
+
+	return nil
+}
+
+// defaultURLPorts maps schemes to the ports that ParseURL strips as their defaults.
+var defaultURLPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+	"ftp":   "21",
+}
+
+// URL represents a nullable absolute URL with a host, of any scheme,
+// such as "wss://rpc.example.com", "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+// or "s3://bucket/key".
+// It is the generalization of HTTPURL to schemes other than http and https.
+//
+// Its Scan and UnmarshalJSON accept any scheme and host, including internal ones such as localhost.
+// Use URLOf instead for URLs from untrusted input, to reject those not allowed by a URLPolicy.
+type URL struct {
+	URL   url.URL
+	Valid bool
+}
+
+// NewURL returns a new URL.
+// Unlike ParseURL, it does not normalize u.
+func NewURL(u url.URL, valid bool) URL {
+	return URL{
+		URL:   u,
+		Valid: valid,
+	}
+}
+
+// ParseURL returns a new valid URL parsed from s, an absolute URL with a host.
+// It normalizes the URL by lowercasing its scheme and host and stripping the default port of its scheme.
+// It accepts any scheme and host; use URLPolicy.Parse or ParseURLOf to restrict them.
+func ParseURL(s string) (URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return URL{}, err
+	}
+
+	if u.Scheme == "" || u.Opaque != "" || u.Hostname() == "" {
+		return URL{}, fmt.Errorf("invalid URL %q: not an absolute URL with a host", s)
+	}
+
+	// url.Parse leaves colons in unbracketed hosts such as "a::" as they are.
+	if !strings.HasPrefix(u.Host, "[") && strings.Contains(u.Hostname(), ":") {
+		return URL{}, fmt.Errorf("invalid URL %q: invalid host %q", s, u.Host)
+	}
+
+	u.Host = strings.ToLower(u.Host)
+	if port := u.Port(); port == "" || port == defaultURLPorts[u.Scheme] {
+		u.Host = strings.TrimSuffix(u.Host, ":"+port)
+	}
+
+	return NewURL(*u, true), nil
+}
+
+// NullableString returns the value as a String.
+func (n URL) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(n.URL.String(), true)
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a string, or nil if invalid.
+func (n URL) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.URL.String(), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts one of the following:
+//   - string (any URL ParseURL accepts)
+//   - []byte (any URL ParseURL accepts)
+//   - nil
+func (n *URL) Scan(src any) error {
+	if src == nil {
+		n.URL, n.Valid = url.URL{}, false
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case string:
+		return n.parse(v)
+
+	case []byte:
+		return n.parse(string(v))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string, or null if invalid.
+func (n URL) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.URL.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string holding any URL ParseURL accepts, or null.
+func (n *URL) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.URL, n.Valid = url.URL{}, false
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	return n.parse(s)
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (a WebSocket endpoint, an IPFS URI, an S3 location with an escaped key,
+// or an IPv6 host with a port, a query and a fragment), or a random URL of a common scheme.
+func (URL) Generate(r *rand.Rand, size int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(NewURL(url.URL{}, false))
+	}
+
+	if s, ok := generateEdge(r,
+		"wss://rpc.example.com/v1",
+		"ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+		"s3://bucket/a%20b/c.json",
+		"https://[2001:db8::1]:8443/?q=1#f",
+	); ok {
+		u, _ := url.Parse(s)
+
+		return reflect.ValueOf(NewURL(*u, true))
+	}
+
+	const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+	var b strings.Builder
+	b.WriteString([]string{"http", "https", "ws", "wss", "ipfs", "s3"}[r.Intn(6)])
+	b.WriteString("://")
+	for range 1 + r.Intn(max(size, 1)) {
+		b.WriteByte(letters[r.Intn(len(letters))])
+	}
+	for range r.Intn(4) {
+		b.WriteByte('/')
+		for range r.Intn(8) {
+			b.WriteByte(letters[r.Intn(len(letters))])
+		}
+	}
+
+	u, _ := url.Parse(b.String())
+
+	return reflect.ValueOf(NewURL(*u, true))
+}
+
+func (n *URL) parse(s string) error {
+	u, err := ParseURL(s)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	*n = u
+
+	return nil
+}
+
+// matchURLHost reports whether host, normalized by normalizeURLHost,
+// matches any of hosts, in the form of URLPolicy.AllowedHosts.
+func matchURLHost(hosts []string, host string) bool {
+	for _, h := range hosts {
+		h = normalizeURLHost(h)
+		if h == host || strings.HasPrefix(h, ".") && strings.HasSuffix(host, h) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeURLHost lowercases host and strips its trailing dot,
+// so that the fully qualified "example.com." matches "example.com".
+func normalizeURLHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
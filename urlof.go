@@ -0,0 +1,113 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/rand"
+	"net/url"
+	"reflect"
+)
+
+// URLPolicyProvider is implemented by the types, usually empty structs, that set the URLPolicy of URLOf, such as:
+//
+//	type PublicURL struct{}
+//
+//	func (PublicURL) URLPolicy() nullable.URLPolicy {
+//		return nullable.URLPolicy{
+//			Schemes:     []string{"https", "wss"},
+//			DeniedHosts: []string{"localhost", ".internal"},
+//		}
+//	}
+type URLPolicyProvider interface {
+	URLPolicy() URLPolicy
+}
+
+// URLOf represents a nullable URL restricted by P's URLPolicy.
+// Unlike URL, its Scan and UnmarshalJSON reject URLs that the policy does not allow,
+// as HTTPURL rejects schemes other than http and https.
+// It is stored and encoded in JSON exactly as URL, and converts to and from it.
+type URLOf[P URLPolicyProvider] URL
+
+// NewURLOf returns a new URLOf.
+// Unlike ParseURLOf, it neither normalizes u nor checks it against P's URLPolicy.
+func NewURLOf[P URLPolicyProvider](u url.URL, valid bool) URLOf[P] {
+	return URLOf[P](NewURL(u, valid))
+}
+
+// ParseURLOf returns a new valid URLOf parsed from s as ParseURL does,
+// and returns an error if its scheme or host is not allowed by P's URLPolicy.
+func ParseURLOf[P URLPolicyProvider](s string) (URLOf[P], error) {
+	n, err := urlPolicy[P]().Parse(s)
+	if err != nil {
+		return URLOf[P]{}, err
+	}
+
+	return URLOf[P](n), nil
+}
+
+// NullableString returns the value as a String.
+func (n URLOf[P]) NullableString() String {
+	return URL(n).NullableString()
+}
+
+// Value implements driver.Valuer.
+// It returns the same driver.Value as URL.Value.
+func (n URLOf[P]) Value() (driver.Value, error) {
+	return URL(n).Value()
+}
+
+// Scan implements sql.Scanner.
+// It accepts the same sources as URL.Scan, holding a URL allowed by P's URLPolicy.
+func (n *URLOf[P]) Scan(src any) error {
+	var u URL
+	if err := u.Scan(src); err != nil {
+		return err
+	}
+
+	return n.set(u)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the same JSON as URL.MarshalJSON.
+func (n URLOf[P]) MarshalJSON() ([]byte, error) {
+	return URL(n).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts the same JSON as URL.UnmarshalJSON, holding a URL allowed by P's URLPolicy.
+func (n *URLOf[P]) UnmarshalJSON(b []byte) error {
+	var u URL
+	if err := u.UnmarshalJSON(b); err != nil {
+		return err
+	}
+
+	return n.set(u)
+}
+
+// Generate implements quick.Generator.
+// It returns the values of URL.Generate that P's URLPolicy allows, and null for the others.
+func (URLOf[P]) Generate(r *rand.Rand, size int) reflect.Value {
+	u := URL{}.Generate(r, size).Interface().(URL)
+	if urlPolicy[P]().Check(u) != nil {
+		return reflect.ValueOf(URLOf[P]{})
+	}
+
+	return reflect.ValueOf(URLOf[P](u))
+}
+
+func (n *URLOf[P]) set(u URL) error {
+	if err := urlPolicy[P]().Check(u); err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	*n = URLOf[P](u)
+
+	return nil
+}
+
+// urlPolicy returns the URLPolicy of the zero value of P.
+func urlPolicy[P URLPolicyProvider]() URLPolicy {
+	var p P
+
+	return p.URLPolicy()
+}
@@ -0,0 +1,264 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+// publicURL allows https and wss URLs of hosts other than internal ones.
+type publicURL struct{}
+
+func (publicURL) URLPolicy() nullable.URLPolicy {
+	return nullable.URLPolicy{
+		Schemes:     []string{"https", "wss"},
+		DeniedHosts: []string{"localhost", "169.254.169.254", ".internal"},
+	}
+}
+
+func mustParseURLOf(s string) nullable.URLOf[publicURL] {
+	u, err := nullable.ParseURLOf[publicURL](s)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
+
+func TestURLOf(t *testing.T) {
+	var n nullable.URLOf[publicURL]
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+}
+
+func TestURLOf_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.URLOf[publicURL]]{
+		Valid: []nullable.URLOf[publicURL]{
+			mustParseURLOf("https://m0t0k1ch1.com"),
+			mustParseURLOf("wss://rpc.example.com/v1"),
+		},
+		InvalidScan: []any{
+			true,
+			"",
+			"http://example.com",
+			"https://localhost./admin",
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`""`),
+			[]byte(`"s3://bucket/key"`),
+			[]byte(`"https://db.internal"`),
+		},
+	})
+}
+
+func TestParseURLOf(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			want string
+		}{
+			{
+				"invalid URL",
+				"/a",
+				`invalid URL "/a": not an absolute URL with a host`,
+			},
+			{
+				"scheme not allowed",
+				"http://example.com",
+				`invalid URL "http://example.com": scheme "http" is not allowed`,
+			},
+			{
+				"host denied",
+				"https://169.254.169.254/latest/meta-data",
+				`invalid URL "https://169.254.169.254/latest/meta-data": host "169.254.169.254" is not allowed`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := nullable.ParseURLOf[publicURL](tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		u, err := nullable.ParseURLOf[publicURL]("HTTPS://Example.com:443/a")
+		require.NoError(t, err)
+		require.Equal(t, nullable.NewString("https://example.com/a", true), u.NullableString())
+	})
+}
+
+func TestURLOf_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"bool",
+				true,
+				"unsupported source type: bool",
+			},
+			{
+				"string: relative",
+				"/a",
+				"invalid source",
+			},
+			{
+				"string: scheme not allowed",
+				"http://example.com",
+				`invalid source: invalid URL "http://example.com": scheme "http" is not allowed`,
+			},
+			{
+				"[]byte: host denied",
+				[]byte("wss://LOCALHOST.:8443"),
+				`invalid source: invalid URL "wss://localhost.:8443": host "localhost" is not allowed`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.URLOf[publicURL]
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.URLOf[publicURL]
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewURLOf[publicURL](url.URL{}, false),
+			},
+			{
+				"[]byte",
+				[]byte("HTTPS://Example.com:443/a"),
+				mustParseURLOf("https://example.com/a"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.URLOf[publicURL]
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestURLOf_UnmarshalJSON(t *testing.T) {
+	type request struct {
+		Callback nullable.URLOf[publicURL] `json:"callback"`
+	}
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"number",
+				[]byte(`{"callback":0}`),
+				"cannot unmarshal number",
+			},
+			{
+				"scheme not allowed",
+				[]byte(`{"callback":"ftp://example.com"}`),
+				`invalid source: invalid URL "ftp://example.com": scheme "ftp" is not allowed`,
+			},
+			{
+				"host denied",
+				[]byte(`{"callback":"https://db.internal./x"}`),
+				`invalid source: invalid URL "https://db.internal./x": host "db.internal" is not allowed`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var req request
+				err := json.Unmarshal(tc.in, &req)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.URLOf[publicURL]
+		}{
+			{
+				"null",
+				[]byte(`{"callback":null}`),
+				nullable.NewURLOf[publicURL](url.URL{}, false),
+			},
+			{
+				"string",
+				[]byte(`{"callback":"wss://RPC.example.com:443/v1"}`),
+				mustParseURLOf("wss://rpc.example.com/v1"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var req request
+				err := json.Unmarshal(tc.in, &req)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, req.Callback)
+			})
+		}
+	})
+}
+
+func TestURLOf_Generate(t *testing.T) {
+	ns := generate[nullable.URLOf[publicURL]](t, 1000)
+
+	require.Contains(t, ns, nullable.NewURLOf[publicURL](url.URL{}, false))
+	require.Contains(t, ns, mustParseURLOf("wss://rpc.example.com/v1"))
+
+	for _, n := range ns {
+		require.NoError(t, publicURL{}.URLPolicy().Check(nullable.URL(n)))
+	}
+}
+
+func FuzzURLOf_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.URLOf[publicURL]](f,
+		[]byte(""),
+		[]byte("https://example.com:443/a?q=1#f"),
+		[]byte("http://example.com"),
+		[]byte("https://localhost./admin"),
+	)
+}
+
+func FuzzURLOf_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.URLOf[publicURL]](f,
+		[]byte(`0`),
+		[]byte(`null`),
+		[]byte(`"HTTPS://Example.com:443"`),
+		[]byte(`"s3://bucket/key"`),
+		[]byte(`"https://db.internal."`),
+	)
+}
@@ -0,0 +1,239 @@
+package nullable
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// UUID represents a nullable RFC 9562 UUID.
+// It is stored as its canonical string, for PostgreSQL uuid and CHAR(36) columns;
+// UUIDBinary is stored as its 16 bytes instead, for BINARY(16) columns.
+type UUID struct {
+	UUID  [16]byte
+	Valid bool
+}
+
+// NewUUID returns a new UUID.
+func NewUUID(u [16]byte, valid bool) UUID {
+	return UUID{
+		UUID:  u,
+		Valid: valid,
+	}
+}
+
+// NewUUIDv4 returns a new valid UUID of version 4, which is random.
+func NewUUIDv4() UUID {
+	var u [16]byte
+	cryptorand.Read(u[:])
+
+	return NewUUID(withUUIDVersion(u, 4), true)
+}
+
+// NewUUIDv7 returns a new valid UUID of version 7, which is ordered by the current Unix time
+// in milliseconds and otherwise random.
+func NewUUIDv7() UUID {
+	var u [16]byte
+	cryptorand.Read(u[6:])
+
+	ms := uint64(time.Now().UnixMilli())
+	binary.BigEndian.PutUint16(u[0:2], uint16(ms>>32))
+	binary.BigEndian.PutUint32(u[2:6], uint32(ms))
+
+	return NewUUID(withUUIDVersion(u, 7), true)
+}
+
+// ParseUUID returns a new valid UUID parsed from one of the following forms,
+// with hex digits in either case:
+//   - canonical: 6ba7b810-9dad-11d1-80b4-00c04fd430c8
+//   - braced: {6ba7b810-9dad-11d1-80b4-00c04fd430c8}
+//   - URN: urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8
+func ParseUUID(s string) (UUID, error) {
+	t := s
+	switch {
+
+	case len(t) == 38 && t[0] == '{' && t[37] == '}':
+		t = t[1:37]
+
+	case len(t) == 45 && strings.EqualFold(t[:9], "urn:uuid:"):
+		t = t[9:]
+	}
+
+	if len(t) != 36 || t[8] != '-' || t[13] != '-' || t[18] != '-' || t[23] != '-' {
+		return UUID{}, fmt.Errorf("invalid UUID %q", s)
+	}
+
+	var u [16]byte
+	if _, err := hex.Decode(u[:], []byte(t[0:8]+t[9:13]+t[14:18]+t[19:23]+t[24:36])); err != nil {
+		return UUID{}, fmt.Errorf("invalid UUID %q", s)
+	}
+
+	return NewUUID(u, true), nil
+}
+
+// NullableString returns the value as a String in canonical form.
+func (n UUID) NullableString() String {
+	if !n.Valid {
+		return NewString("", false)
+	}
+
+	return NewString(n.format(), true)
+}
+
+// Version returns the version of the value, or 0 if invalid.
+func (n UUID) Version() int {
+	if !n.Valid {
+		return 0
+	}
+
+	return int(n.UUID[6] >> 4)
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a string in canonical form, or nil if invalid.
+func (n UUID) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.format(), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts one of the following:
+//   - string (any form ParseUUID accepts)
+//   - []byte (16 bytes, or any form ParseUUID accepts)
+//   - nil
+func (n *UUID) Scan(src any) error {
+	if src == nil {
+		*n = UUID{}
+
+		return nil
+	}
+
+	switch v := src.(type) {
+
+	case string:
+		return n.parse(v)
+
+	case []byte:
+		if len(v) == 16 {
+			*n = NewUUID([16]byte(v), true)
+
+			return nil
+		}
+
+		return n.parse(string(v))
+
+	default:
+		return fmt.Errorf("unsupported source type: %T", src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the value as a JSON string in canonical form, or null if invalid.
+func (n UUID) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.format())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string in any form ParseUUID accepts, or null.
+func (n *UUID) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = UUID{}
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	return n.parse(s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns the value in canonical form, or an empty text if invalid.
+func (n UUID) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	return []byte(n.format()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts any form ParseUUID accepts, or an empty text as null.
+func (n *UUID) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*n = UUID{}
+
+		return nil
+	}
+
+	return n.parse(string(b))
+}
+
+// Generate implements quick.Generator.
+// It returns null, an edge case (the nil UUID or the max UUID), or a random version 4 UUID.
+func (UUID) Generate(r *rand.Rand, _ int) reflect.Value {
+	if generateNull(r) {
+		return reflect.ValueOf(UUID{})
+	}
+
+	if u, ok := generateEdge(r, [16]byte{}, [16]byte(bytes.Repeat([]byte{0xff}, 16))); ok {
+		return reflect.ValueOf(NewUUID(u, true))
+	}
+
+	var u [16]byte
+	r.Read(u[:])
+
+	return reflect.ValueOf(NewUUID(withUUIDVersion(u, 4), true))
+}
+
+func (n UUID) format() string {
+	var b [36]byte
+	hex.Encode(b[0:8], n.UUID[0:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], n.UUID[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], n.UUID[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], n.UUID[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:36], n.UUID[10:16])
+
+	return string(b[:])
+}
+
+func (n *UUID) parse(s string) error {
+	u, err := ParseUUID(s)
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+
+	*n = u
+
+	return nil
+}
+
+// withUUIDVersion returns u with its version set to version and its variant to that of RFC 9562.
+func withUUIDVersion(u [16]byte, version byte) [16]byte {
+	u[6] = u[6]&0x0f | version<<4
+	u[8] = u[8]&0x3f | 0x80
+
+	return u
+}
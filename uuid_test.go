@@ -0,0 +1,568 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+var (
+	testUUID = [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	maxUUID  = [16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+)
+
+func TestUUID(t *testing.T) {
+	var n nullable.UUID
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextMarshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextUnmarshaler)(nil), &n)
+}
+
+func TestUUID_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.UUID]{
+		Valid: []nullable.UUID{
+			nullable.NewUUID([16]byte{}, true),
+			nullable.NewUUID(testUUID, true),
+			nullable.NewUUID(maxUUID, true),
+			nullable.NewUUIDv4(),
+			nullable.NewUUIDv7(),
+		},
+		InvalidScan: []any{
+			"",
+			"6ba7b810-9dad-11d1-80b4-00c04fd430c",
+			[]byte{0x6b, 0xa7, 0xb8, 0x10},
+			int64(0),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`""`),
+			[]byte(`"6ba7b8109dad11d180b400c04fd430c8"`),
+		},
+	})
+}
+
+func TestNewUUIDv4(t *testing.T) {
+	n := nullable.NewUUIDv4()
+	require.True(t, n.Valid)
+	require.Equal(t, 4, n.Version())
+	require.Equal(t, byte(0x80), n.UUID[8]&0xc0)
+
+	require.NotEqual(t, n, nullable.NewUUIDv4())
+}
+
+func TestNewUUIDv7(t *testing.T) {
+	before := time.Now().UnixMilli()
+	n := nullable.NewUUIDv7()
+	after := time.Now().UnixMilli()
+
+	require.True(t, n.Valid)
+	require.Equal(t, 7, n.Version())
+	require.Equal(t, byte(0x80), n.UUID[8]&0xc0)
+
+	var ms int64
+	for _, b := range n.UUID[:6] {
+		ms = ms<<8 | int64(b)
+	}
+	require.GreaterOrEqual(t, ms, before)
+	require.LessOrEqual(t, ms, after)
+}
+
+func TestParseUUID(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			want string
+		}{
+			{
+				"empty",
+				"",
+				`invalid UUID ""`,
+			},
+			{
+				"no hyphens",
+				"6ba7b8109dad11d180b400c04fd430c8",
+				"invalid UUID",
+			},
+			{
+				"misplaced hyphen",
+				"6ba7b81-09dad-11d1-80b4-00c04fd430c8",
+				"invalid UUID",
+			},
+			{
+				"non-hex digit",
+				"6ba7b810-9dad-11d1-80b4-00c04fd430cg",
+				"invalid UUID",
+			},
+			{
+				"unbalanced brace",
+				"{6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+				"invalid UUID",
+			},
+			{
+				"other URN namespace",
+				"urn:oid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+				"invalid UUID",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := nullable.ParseUUID(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			want nullable.UUID
+		}{
+			{
+				"canonical",
+				"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+				nullable.NewUUID(testUUID, true),
+			},
+			{
+				"canonical: uppercase",
+				"6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+				nullable.NewUUID(testUUID, true),
+			},
+			{
+				"braced",
+				"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+				nullable.NewUUID(testUUID, true),
+			},
+			{
+				"URN",
+				"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+				nullable.NewUUID(testUUID, true),
+			},
+			{
+				"URN: uppercase",
+				"URN:UUID:6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+				nullable.NewUUID(testUUID, true),
+			},
+			{
+				"nil",
+				"00000000-0000-0000-0000-000000000000",
+				nullable.NewUUID([16]byte{}, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				n, err := nullable.ParseUUID(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestUUID_NullableString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.UUID
+			want nullable.String
+		}{
+			{
+				"null",
+				nullable.NewUUID([16]byte{}, false),
+				nullable.NewString("", false),
+			},
+			{
+				"nil",
+				nullable.NewUUID([16]byte{}, true),
+				nullable.NewString("00000000-0000-0000-0000-000000000000", true),
+			},
+			{
+				"non-nil",
+				nullable.NewUUID(testUUID, true),
+				nullable.NewString("6ba7b810-9dad-11d1-80b4-00c04fd430c8", true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				s := tc.in.NullableString()
+				require.Equal(t, tc.want, s)
+			})
+		}
+	})
+}
+
+func TestUUID_Version(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.UUID
+			want int
+		}{
+			{
+				"null",
+				nullable.NewUUID(testUUID, false),
+				0,
+			},
+			{
+				"nil",
+				nullable.NewUUID([16]byte{}, true),
+				0,
+			},
+			{
+				"version 1",
+				nullable.NewUUID(testUUID, true),
+				1,
+			},
+			{
+				"max",
+				nullable.NewUUID(maxUUID, true),
+				15,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.want, tc.in.Version())
+			})
+		}
+	})
+}
+
+func TestUUID_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.UUID
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewUUID([16]byte{}, false),
+				nil,
+			},
+			{
+				"valid",
+				nullable.NewUUID(testUUID, true),
+				"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestUUID_Scan(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want string
+		}{
+			{
+				"int64",
+				int64(0),
+				"unsupported source type: int64",
+			},
+			{
+				"string: invalid",
+				"invalid",
+				"invalid source",
+			},
+			{
+				"[]byte: 15 bytes",
+				testUUID[:15],
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.UUID
+				err := n.Scan(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			want nullable.UUID
+		}{
+			{
+				"nil",
+				nil,
+				nullable.NewUUID([16]byte{}, false),
+			},
+			{
+				"string",
+				"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+				nullable.NewUUID(testUUID, true),
+			},
+			{
+				"[]byte: 16 bytes",
+				testUUID[:],
+				nullable.NewUUID(testUUID, true),
+			},
+			{
+				"[]byte: text",
+				[]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+				nullable.NewUUID(testUUID, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.UUID
+				err := n.Scan(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+
+	t.Run("success: copies the source", func(t *testing.T) {
+		src := testUUID
+
+		var n nullable.UUID
+		require.NoError(t, n.Scan(src[:]))
+
+		src[0] = 0x00
+
+		require.Equal(t, testUUID, n.UUID)
+	})
+}
+
+func TestUUID_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.UUID
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewUUID([16]byte{}, false),
+				[]byte(`null`),
+			},
+			{
+				"non-nil",
+				nullable.NewUUID(testUUID, true),
+				[]byte(`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := json.Marshal(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestUUID_UnmarshalJSON(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want string
+		}{
+			{
+				"boolean",
+				[]byte(`true`),
+				"",
+			},
+			{
+				"string: invalid",
+				[]byte(`"invalid"`),
+				"invalid source",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.UUID
+				err := n.UnmarshalJSON(tc.in)
+				require.ErrorContains(t, err, tc.want)
+			})
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.UUID
+		}{
+			{
+				"null",
+				[]byte(`null`),
+				nullable.NewUUID([16]byte{}, false),
+			},
+			{
+				"string: canonical",
+				[]byte(`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`),
+				nullable.NewUUID(testUUID, true),
+			},
+			{
+				"string: URN",
+				[]byte(`"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8"`),
+				nullable.NewUUID(testUUID, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.UUID
+				err := n.UnmarshalJSON(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestUUID_MarshalText(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.UUID
+			want []byte
+		}{
+			{
+				"null",
+				nullable.NewUUID([16]byte{}, false),
+				[]byte{},
+			},
+			{
+				"non-nil",
+				nullable.NewUUID(testUUID, true),
+				[]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := tc.in.MarshalText()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b)
+			})
+		}
+	})
+}
+
+func TestUUID_UnmarshalText(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		var n nullable.UUID
+		err := n.UnmarshalText([]byte("invalid"))
+		require.ErrorContains(t, err, "invalid source")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			want nullable.UUID
+		}{
+			{
+				"empty",
+				[]byte{},
+				nullable.NewUUID([16]byte{}, false),
+			},
+			{
+				"braced",
+				[]byte("{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"),
+				nullable.NewUUID(testUUID, true),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var n nullable.UUID
+				err := n.UnmarshalText(tc.in)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, n)
+			})
+		}
+	})
+}
+
+func TestUUID_Generate(t *testing.T) {
+	ns := generate[nullable.UUID](t, 1000)
+
+	tcs := []struct {
+		name string
+		want nullable.UUID
+	}{
+		{
+			"null",
+			nullable.NewUUID([16]byte{}, false),
+		},
+		{
+			"nil",
+			nullable.NewUUID([16]byte{}, true),
+		},
+		{
+			"max",
+			nullable.NewUUID(maxUUID, true),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, ns, tc.want)
+		})
+	}
+}
+
+func FuzzUUID_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.UUID](f,
+		[]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+		[]byte("{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"),
+		[]byte("urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+		testUUID[:],
+		[]byte("invalid"),
+	)
+}
+
+func FuzzUUID_UnmarshalJSON(f *testing.F) {
+	nullabletest.FuzzJSON[nullable.UUID](f,
+		[]byte(`true`),
+		[]byte(`""`),
+		[]byte(`null`),
+		[]byte(`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`),
+		[]byte(`"URN:UUID:6BA7B810-9DAD-11D1-80B4-00C04FD430C8"`),
+	)
+}
+
+func FuzzUUID_UnmarshalText(f *testing.F) {
+	nullabletest.FuzzText[nullable.UUID](f,
+		[]byte(""),
+		[]byte("invalid"),
+		[]byte("{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"),
+	)
+}
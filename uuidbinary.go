@@ -0,0 +1,72 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"math/rand"
+	"reflect"
+)
+
+// UUIDBinary represents a nullable RFC 9562 UUID stored as its 16 bytes, for BINARY(16) columns.
+// It is scanned and encoded exactly as UUID, and converts to and from it.
+type UUIDBinary UUID
+
+// NewUUIDBinary returns a new UUIDBinary.
+func NewUUIDBinary(u [16]byte, valid bool) UUIDBinary {
+	return UUIDBinary(NewUUID(u, valid))
+}
+
+// NullableString returns the value as a String in canonical form.
+func (n UUIDBinary) NullableString() String {
+	return UUID(n).NullableString()
+}
+
+// Version returns the version of the value, or 0 if invalid.
+func (n UUIDBinary) Version() int {
+	return UUID(n).Version()
+}
+
+// Value implements driver.Valuer.
+// It returns the value as a []byte of 16 bytes, or nil if invalid.
+func (n UUIDBinary) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.UUID[:], nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts the same sources as UUID.Scan.
+func (n *UUIDBinary) Scan(src any) error {
+	return (*UUID)(n).Scan(src)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It returns the same JSON as UUID.MarshalJSON.
+func (n UUIDBinary) MarshalJSON() ([]byte, error) {
+	return UUID(n).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts the same JSON values as UUID.UnmarshalJSON.
+func (n *UUIDBinary) UnmarshalJSON(b []byte) error {
+	return (*UUID)(n).UnmarshalJSON(b)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns the same text as UUID.MarshalText.
+func (n UUIDBinary) MarshalText() ([]byte, error) {
+	return UUID(n).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts the same texts as UUID.UnmarshalText.
+func (n *UUIDBinary) UnmarshalText(b []byte) error {
+	return (*UUID)(n).UnmarshalText(b)
+}
+
+// Generate implements quick.Generator.
+// It returns the same values as UUID.Generate.
+func (UUIDBinary) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(UUIDBinary(UUID{}.Generate(r, size).Interface().(UUID)))
+}
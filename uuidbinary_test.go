@@ -0,0 +1,86 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/nullable/v3"
+	"github.com/m0t0k1ch1-go/nullable/v3/nullabletest"
+)
+
+func TestUUIDBinary(t *testing.T) {
+	var n nullable.UUIDBinary
+	require.Implements(t, (*driver.Valuer)(nil), &n)
+	require.Implements(t, (*sql.Scanner)(nil), &n)
+	require.Implements(t, (*json.Marshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextMarshaler)(nil), &n)
+	require.Implements(t, (*json.Unmarshaler)(nil), &n)
+	require.Implements(t, (*encoding.TextUnmarshaler)(nil), &n)
+}
+
+func TestUUIDBinary_Conformance(t *testing.T) {
+	nullabletest.RunConformance(t, nullabletest.Spec[nullable.UUIDBinary]{
+		Valid: []nullable.UUIDBinary{
+			nullable.NewUUIDBinary([16]byte{}, true),
+			nullable.NewUUIDBinary(testUUID, true),
+			nullable.NewUUIDBinary(maxUUID, true),
+		},
+		InvalidScan: []any{
+			"",
+			[]byte{0x6b, 0xa7, 0xb8, 0x10},
+			int64(0),
+		},
+		InvalidJSON: [][]byte{
+			[]byte(`true`),
+			[]byte(`"6ba7b8109dad11d180b400c04fd430c8"`),
+		},
+	})
+}
+
+func TestUUIDBinary_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   nullable.UUIDBinary
+			want driver.Value
+		}{
+			{
+				"null",
+				nullable.NewUUIDBinary([16]byte{}, false),
+				nil,
+			},
+			{
+				"valid",
+				nullable.NewUUIDBinary(testUUID, true),
+				testUUID[:],
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.in.Value()
+				require.NoError(t, err)
+				require.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestUUIDBinary_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(nullable.NewUUIDBinary(testUUID, true))
+	require.NoError(t, err)
+	require.Equal(t, []byte(`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`), b)
+}
+
+func FuzzUUIDBinary_Scan(f *testing.F) {
+	nullabletest.FuzzScan[nullable.UUIDBinary](f,
+		testUUID[:],
+		[]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+		[]byte("invalid"),
+	)
+}